@@ -0,0 +1,20 @@
+package shopify
+
+// Address represents a postal address, as used by orders and customers.
+type Address struct {
+	FirstName    string   `json:"first_name,omitempty"`
+	LastName     string   `json:"last_name,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Company      string   `json:"company,omitempty"`
+	Address1     string   `json:"address1,omitempty"`
+	Address2     string   `json:"address2,omitempty"`
+	City         string   `json:"city,omitempty"`
+	Province     string   `json:"province,omitempty"`
+	ProvinceCode string   `json:"province_code,omitempty"`
+	Country      string   `json:"country,omitempty"`
+	CountryCode  string   `json:"country_code,omitempty"`
+	Zip          string   `json:"zip,omitempty"`
+	Phone        string   `json:"phone,omitempty"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+}
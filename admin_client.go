@@ -8,10 +8,10 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"mime"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -23,11 +23,35 @@ var DefaultAdminClient = &AdminClient{}
 // AdminClient represents a Shopify client, that can interact with the Shopify REST Admin API.
 //
 // An AdminClient takes its credentials from the specified context. See WithShop and WithAccessToken.
+//
+// Requests are sent to the versioned Admin API. See WithAPIVersion.
+//
+// Requests are throttled to stay within the Shopify REST Admin API rate
+// limits. The throttling state of a shop is shared by all AdminClient
+// instances.
+//
+// Unexpected responses from the Shopify API are reported as *APIError values.
 type AdminClient struct {
 	// HTTPClient is the HTTP client to use for requests.
 	//
 	// If none is specified, http.DefaultClient is used.
 	HTTPClient *http.Client
+
+	// RetryPolicy is the policy to use for retrying failed requests.
+	//
+	// If none is specified, DefaultRetryPolicy is used.
+	RetryPolicy *RetryPolicy
+
+	// APIVersion is the API version to use for requests.
+	//
+	// If the context specifies an API version (see WithAPIVersion), it takes
+	// precedence. If none is specified, DefaultAPIVersion is used.
+	APIVersion APIVersion
+
+	// Logger is the logger to report API deprecations to.
+	//
+	// If none is specified, the standard logger of the log package is used.
+	Logger *log.Logger
 }
 
 const headerXShopifyAccessToken = "X-Shopify-Access-Token"
@@ -53,6 +77,82 @@ func (c *AdminClient) httpClient() *http.Client {
 	return DefaultHTTPClient
 }
 
+func (c *AdminClient) apiVersion(ctx context.Context) APIVersion {
+	if version, ok := GetAPIVersion(ctx); ok {
+		return version
+	}
+
+	if c.APIVersion != "" {
+		return c.APIVersion
+	}
+
+	return DefaultAPIVersion
+}
+
+func (c *AdminClient) logf(format string, args ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, args...)
+		return
+	}
+
+	log.Printf(format, args...)
+}
+
+func (c *AdminClient) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
+	}
+
+	return DefaultRetryPolicy
+}
+
+// do sends a request to the Shopify API, retrying it according to the retry
+// policy.
+func (c *AdminClient) do(req *http.Request) (*http.Response, error) {
+	return c.retryPolicy().do(req, c.send)
+}
+
+// send sends a request to the Shopify API.
+//
+// It blocks until the shop leaky bucket has enough room for the request.
+func (c *AdminClient) send(req *http.Request) (*http.Response, error) {
+	bucket := getLeakyBucket(Shop(req.URL.Host))
+
+	if err := bucket.reserve(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient().Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.updateFromResponse(resp)
+	c.checkAPIVersion(req, resp)
+
+	return resp, nil
+}
+
+// checkAPIVersion reports deprecated calls, as well as calls for which
+// Shopify served a different version than the requested one, which happens
+// when the requested version is no longer supported.
+func (c *AdminClient) checkAPIVersion(req *http.Request, resp *http.Response) {
+	if reason := resp.Header.Get(headerXShopifyAPIDeprecatedReason); reason != "" {
+		c.logf("shopify: deprecated API call to %s %s: %s", req.Method, req.URL.Path, reason)
+	}
+
+	version := APIVersion(resp.Header.Get(headerXShopifyAPIVersion))
+
+	if version != "" && version != c.apiVersion(req.Context()) {
+		c.logf("shopify: requested API version `%s` for %s %s but Shopify served version `%s`", c.apiVersion(req.Context()), req.Method, req.URL.Path, version)
+	}
+}
+
+// newURL returns the URL for the specified path.
+//
+// Relative paths, such as `script_tags.json`, are resolved against the
+// versioned Admin API root. Absolute paths are used as-is.
 func (c *AdminClient) newURL(ctx context.Context, path string, values url.Values) (*url.URL, error) {
 	shop, ok := GetShop(ctx)
 
@@ -60,6 +160,16 @@ func (c *AdminClient) newURL(ctx context.Context, path string, values url.Values
 		return nil, errors.New("context contains no shop")
 	}
 
+	if !strings.HasPrefix(path, "/") {
+		version := c.apiVersion(ctx)
+
+		if err := version.Validate(); err != nil {
+			return nil, err
+		}
+
+		path = fmt.Sprintf("/admin/api/%s/%s", version, path)
+	}
+
 	if values == nil {
 		values = url.Values{}
 	}
@@ -82,7 +192,9 @@ func (c *AdminClient) newRequest(ctx context.Context, method string, path string
 			return nil, fmt.Errorf("failed to JSON-marshal the request body (%#v): %s", body, err)
 		}
 
-		r = bytes.NewBuffer(data)
+		// A bytes.Reader lets http.NewRequest define GetBody, so that the
+		// request can be retried.
+		r = bytes.NewReader(data)
 	}
 
 	u, err := c.newURL(ctx, path, values)
@@ -111,38 +223,51 @@ func (c *AdminClient) newRequest(ctx context.Context, method string, path string
 	return req, nil
 }
 
-// Pagination represents pagination options.
-type Pagination struct {
-	Limit   int
-	Page    int
-	SinceID int
-}
+// doJSON sends a request with an optional JSON body and decodes the JSON
+// response into result, unless result is nil.
+//
+// If the response status code differs from the expected one, an APIError is
+// returned. The response headers are returned on success.
+func (c *AdminClient) doJSON(ctx context.Context, method string, path string, values url.Values, body interface{}, expectedStatusCode int, result interface{}) (http.Header, error) {
+	req, err := c.newRequest(ctx, method, path, values, body)
 
-func (o *Pagination) injectInto(values url.Values) {
-	if o == nil {
-		return
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	if o.Limit != 0 {
-		values.Set("limit", strconv.Itoa(o.Limit))
+	resp, err := c.do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
-	if o.Page != 0 {
-		values.Set("page", strconv.Itoa(o.Page))
+	defer flushAndCloseBody(resp.Body)
+
+	if resp.StatusCode != expectedStatusCode {
+		return nil, newAPIError(resp)
 	}
 
-	if o.SinceID != 0 {
-		values.Set("since_id", strconv.Itoa(o.SinceID))
+	if result != nil {
+		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return nil, fmt.Errorf("unable to parse response: %s", err)
+		}
 	}
+
+	return resp.Header, nil
 }
 
-const (
-	// DefaultLimit is the default limit, as specified by Shopify.
-	DefaultLimit = 50
+// getCount fetches the result of a `count.json` endpoint.
+func (c *AdminClient) getCount(ctx context.Context, path string, values url.Values) (int, error) {
+	result := &struct {
+		Count int `json:"count"`
+	}{}
 
-	// MaxLimit is the maximum allowed limit, as specified by Shopify.
-	MaxLimit = 250
-)
+	if _, err := c.doJSON(ctx, http.MethodGet, path, values, nil, http.StatusOK, result); err != nil {
+		return 0, err
+	}
+
+	return result.Count, nil
+}
 
 // SelectedFields represents a list of fields to fetch.
 type SelectedFields []string
@@ -155,6 +280,20 @@ func (f SelectedFields) injectInto(values url.Values) {
 	values.Set("fields", strings.Join(f, ","))
 }
 
+// setString sets a query parameter, unless the value is empty.
+func setString(values url.Values, key string, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+// setTime sets a time query parameter, unless the time is zero.
+func setTime(values url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		values.Set(key, t.Format(time.RFC3339))
+	}
+}
+
 // ScriptTagEvent represents a script tag event.
 type ScriptTagEvent string
 
@@ -235,7 +374,7 @@ func (c *AdminClient) EnsureScriptTag(ctx context.Context, scriptTag ScriptTag)
 		result, err := c.GetScriptTag(ctx, scriptTag.ID, nil)
 
 		if err != nil {
-			return nil, fmt.Errorf("failed to lookup existing script tag with ID `%d`: %s", scriptTag.ID, err)
+			return nil, fmt.Errorf("failed to lookup existing script tag with ID `%d`: %w", scriptTag.ID, err)
 		}
 
 		if result != nil {
@@ -278,34 +417,21 @@ func (c *AdminClient) EnsureScriptTag(ctx context.Context, scriptTag ScriptTag)
 
 // GetAllScriptTags retrieves a list of all script tags.
 func (c *AdminClient) GetAllScriptTags(ctx context.Context, fields SelectedFields) ([]ScriptTag, error) {
-	count, err := c.GetScriptTagsCount(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to count script tags: %s", err)
-	}
-
-	if count == 0 {
-		return nil, nil
-	}
-
 	pagination := &Pagination{
 		Limit: MaxLimit,
 	}
 
 	var result []ScriptTag
 
-	pageCount := ((count - 1) / pagination.Limit) + 1
-
-	for page := 1; page <= pageCount; page++ {
-		pagination.Page = page
-
-		scriptTags, err := c.GetScriptTags(ctx, pagination, fields)
+	for page := 1; pagination != nil; page++ {
+		scriptTags, links, err := c.GetScriptTags(ctx, pagination, fields)
 
 		if err != nil {
-			return nil, fmt.Errorf("fetching page %d/%d: %s", page, pageCount, err)
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
 		}
 
 		result = append(result, scriptTags...)
+		pagination = links.Next
 	}
 
 	return result, nil
@@ -313,30 +439,29 @@ func (c *AdminClient) GetAllScriptTags(ctx context.Context, fields SelectedField
 
 // GetScriptTags retrieves a list of script tags.
 //
-// To fetch the complete list, use GetAllScriptTags.
-func (c *AdminClient) GetScriptTags(ctx context.Context, pagination *Pagination, fields SelectedFields) ([]ScriptTag, error) {
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllScriptTags.
+func (c *AdminClient) GetScriptTags(ctx context.Context, pagination *Pagination, fields SelectedFields) ([]ScriptTag, *PageLinks, error) {
 	values := url.Values{}
 	pagination.injectInto(values)
 	fields.injectInto(values)
 
-	req, err := c.newRequest(ctx, http.MethodGet, "/admin/script_tags.json", values, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, "script_tags.json", values, nil)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %s", err)
+		return nil, nil, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %s", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+		return nil, nil, newAPIError(resp)
 	}
 
 	result := &struct {
@@ -344,32 +469,30 @@ func (c *AdminClient) GetScriptTags(ctx context.Context, pagination *Pagination,
 	}{}
 
 	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return nil, fmt.Errorf("unable to parse response: %s", err)
+		return nil, nil, fmt.Errorf("unable to parse response: %s", err)
 	}
 
-	return result.ScriptTags, nil
+	return result.ScriptTags, parsePageLinks(resp.Header), nil
 }
 
 // GetScriptTagsCount retrieves the count of all script tags.
 func (c *AdminClient) GetScriptTagsCount(ctx context.Context) (int, error) {
-	req, err := c.newRequest(ctx, http.MethodGet, "/admin/script_tags/count.json", nil, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, "script_tags/count.json", nil, nil)
 
 	if err != nil {
 		return 0, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return 0, fmt.Errorf("request failed: %s", err)
+		return 0, fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		return 0, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+		return 0, newAPIError(resp)
 	}
 
 	result := &struct {
@@ -390,16 +513,16 @@ func (c *AdminClient) GetScriptTag(ctx context.Context, id ScriptTagID, fields S
 	values := url.Values{}
 	fields.injectInto(values)
 
-	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("/admin/script_tags/%d.json", id), values, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("script_tags/%d.json", id), values, nil)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %s", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
@@ -409,9 +532,7 @@ func (c *AdminClient) GetScriptTag(ctx context.Context, id ScriptTagID, fields S
 	case http.StatusNotFound:
 		return nil, nil
 	default:
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	body := &struct {
@@ -445,34 +566,30 @@ func (c *AdminClient) CreateOrUpdateScriptTag(ctx context.Context, scriptTag Scr
 	var err error
 
 	if scriptTag.ID == 0 {
-		req, err = c.newRequest(ctx, http.MethodPost, "/admin/script_tags.json", nil, body)
+		req, err = c.newRequest(ctx, http.MethodPost, "script_tags.json", nil, body)
 	} else {
-		req, err = c.newRequest(ctx, http.MethodPut, fmt.Sprintf("/admin/script_tags/%d.json", scriptTag.ID), nil, body)
+		req, err = c.newRequest(ctx, http.MethodPut, fmt.Sprintf("script_tags/%d.json", scriptTag.ID), nil, body)
 	}
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %s", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
 
 	if scriptTag.ID == 0 {
 		if resp.StatusCode != http.StatusCreated {
-			body, _ := ioutil.ReadAll(resp.Body)
-
-			return nil, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+			return nil, newAPIError(resp)
 		}
 	} else {
 		if resp.StatusCode != http.StatusOK {
-			body, _ := ioutil.ReadAll(resp.Body)
-
-			return nil, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+			return nil, newAPIError(resp)
 		}
 	}
 
@@ -485,24 +602,22 @@ func (c *AdminClient) CreateOrUpdateScriptTag(ctx context.Context, scriptTag Scr
 
 // DeleteScriptTag deletes a script tag.
 func (c *AdminClient) DeleteScriptTag(ctx context.Context, id ScriptTagID) error {
-	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/admin/script_tags/%d.json", id), nil, nil)
+	req, err := c.newRequest(ctx, http.MethodDelete, fmt.Sprintf("script_tags/%d.json", id), nil, nil)
 
 	if err != nil {
 		return fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return fmt.Errorf("request failed: %s", err)
+		return fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		return fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	return nil
@@ -527,18 +642,16 @@ func (c *AdminClient) GetOAuthToken(ctx context.Context, apiKey APIKey, apiSecre
 		return nil, fmt.Errorf("failed to create request: %s", err)
 	}
 
-	resp, err := c.httpClient().Do(req)
+	resp, err := c.do(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %s", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	defer flushAndCloseBody(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-
-		return nil, fmt.Errorf("unexpected return status code of %d (body follows):\n%s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -0,0 +1,64 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestAdminClient instantiates an AdminClient that talks to a local test
+// server, along with a context referencing it as the shop.
+func newTestAdminClient(t *testing.T, handler http.Handler) (context.Context, *AdminClient) {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	ctx = WithShop(ctx, Shop(server.Listener.Addr().String()))
+	ctx = WithAccessToken(ctx, "token")
+
+	client := &AdminClient{
+		HTTPClient: server.Client(),
+	}
+
+	return ctx, client
+}
+
+func TestAdminClientAPIError(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if value := req.Header.Get(headerXShopifyAccessToken); value != "token" {
+			t.Errorf("expected `%s` but got `%s`", "token", value)
+		}
+
+		w.Header().Set(headerXRequestID, "abc-123")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`))
+	}))
+
+	_, err := client.GetScriptTagsCount(ctx)
+
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error but got: %v", err)
+	}
+
+	if err.(*APIError).RequestID != "abc-123" {
+		t.Errorf("expected `%s` but got `%s`", "abc-123", err.(*APIError).RequestID)
+	}
+}
+
+func TestAdminClientGetScriptTagNotFound(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":"Not Found"}`))
+	}))
+
+	scriptTag, err := client.GetScriptTag(ctx, 1, nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if scriptTag != nil {
+		t.Errorf("expected no script tag but got: %#v", scriptTag)
+	}
+}
@@ -0,0 +1,195 @@
+package shopify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const headerXRequestID = "X-Request-Id"
+
+// APIError represents an error returned by the Shopify Admin API.
+//
+// APIError values can be matched with errors.Is against another APIError, in
+// which case only the status codes are compared:
+//
+//	errors.Is(err, &shopify.APIError{StatusCode: http.StatusNotFound})
+//
+// The IsNotFound, IsUnauthorized, IsForbidden, IsRateLimited and
+// IsUnprocessable helpers are provided for the most common cases.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// RequestID is the value of the X-Request-Id response header, which
+	// Shopify support may ask for.
+	RequestID string
+
+	// Messages contains the error messages when Shopify returned its errors
+	// either as a single string or as a list of strings.
+	Messages []string
+
+	// FieldErrors contains the error messages when Shopify returned its
+	// errors as a map of field names to error messages, as is usually the
+	// case with validation errors.
+	FieldErrors map[string][]string
+
+	// Body is the raw response body.
+	Body []byte
+}
+
+// newAPIError instantiates an APIError from a response.
+//
+// The response body is consumed but not closed.
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	err := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(headerXRequestID),
+		Body:       body,
+	}
+
+	err.parseBody()
+
+	return err
+}
+
+func (e *APIError) parseBody() {
+	payload := struct {
+		Errors           json.RawMessage `json:"errors"`
+		Error            string          `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}{}
+
+	if err := json.Unmarshal(e.Body, &payload); err != nil {
+		return
+	}
+
+	// Some endpoints (OAuth, mostly) use the `error` form instead.
+	if len(payload.Errors) == 0 {
+		if payload.ErrorDescription != "" {
+			e.Messages = []string{payload.ErrorDescription}
+		} else if payload.Error != "" {
+			e.Messages = []string{payload.Error}
+		}
+
+		return
+	}
+
+	var message string
+
+	if err := json.Unmarshal(payload.Errors, &message); err == nil {
+		e.Messages = []string{message}
+		return
+	}
+
+	var messages []string
+
+	if err := json.Unmarshal(payload.Errors, &messages); err == nil {
+		e.Messages = messages
+		return
+	}
+
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(payload.Errors, &fields); err == nil {
+		e.FieldErrors = make(map[string][]string, len(fields))
+
+		for field, data := range fields {
+			var messages []string
+
+			if err := json.Unmarshal(data, &messages); err != nil {
+				var message string
+
+				if err := json.Unmarshal(data, &message); err != nil {
+					message = string(data)
+				}
+
+				messages = []string{message}
+			}
+
+			e.FieldErrors[field] = messages
+		}
+	}
+}
+
+// Error returns a human-readable description of the error.
+func (e *APIError) Error() string {
+	s := fmt.Sprintf("shopify: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+
+	if e.RequestID != "" {
+		s += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+
+	var details []string
+
+	details = append(details, e.Messages...)
+
+	fields := make([]string, 0, len(e.FieldErrors))
+
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		for _, message := range e.FieldErrors[field] {
+			details = append(details, fmt.Sprintf("%s %s", field, message))
+		}
+	}
+
+	if len(details) > 0 {
+		s += ": " + strings.Join(details, ", ")
+	} else if len(e.Body) > 0 {
+		s += ": " + string(e.Body)
+	}
+
+	return s
+}
+
+// Is returns true if target is an APIError with the same status code.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+
+	return ok && t.StatusCode == e.StatusCode
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound returns true if err is, or wraps, an APIError with a 404 status.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized returns true if err is, or wraps, an APIError with a 401
+// status.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden returns true if err is, or wraps, an APIError with a 403 status.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsRateLimited returns true if err is, or wraps, an APIError with a 429
+// status.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnprocessable returns true if err is, or wraps, an APIError with a 422
+// status.
+func IsUnprocessable(err error) bool {
+	return hasStatusCode(err, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,124 @@
+package shopify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func newTestResponse(statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Body                string
+		ExpectedMessages    []string
+		ExpectedFieldErrors map[string][]string
+	}{
+		{
+			Name:             "string",
+			Body:             `{"errors":"Not Found"}`,
+			ExpectedMessages: []string{"Not Found"},
+		},
+		{
+			Name:             "list",
+			Body:             `{"errors":["a","b"]}`,
+			ExpectedMessages: []string{"a", "b"},
+		},
+		{
+			Name: "fields",
+			Body: `{"errors":{"src":["can't be blank","is invalid"],"event":"is invalid"}}`,
+			ExpectedFieldErrors: map[string][]string{
+				"src":   {"can't be blank", "is invalid"},
+				"event": {"is invalid"},
+			},
+		},
+		{
+			Name:             "oauth",
+			Body:             `{"error":"invalid_request","error_description":"The authorization code was not found or was already used"}`,
+			ExpectedMessages: []string{"The authorization code was not found or was already used"},
+		},
+		{
+			Name: "not json",
+			Body: `<html></html>`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(headerXRequestID, "abc-123")
+
+			err := newAPIError(newTestResponse(http.StatusUnprocessableEntity, header, testCase.Body))
+
+			if err.RequestID != "abc-123" {
+				t.Errorf("expected `%s` but got `%s`", "abc-123", err.RequestID)
+			}
+
+			if !reflect.DeepEqual(err.Messages, testCase.ExpectedMessages) {
+				t.Errorf("expected: %#v\ngot: %#v", testCase.ExpectedMessages, err.Messages)
+			}
+
+			if !reflect.DeepEqual(err.FieldErrors, testCase.ExpectedFieldErrors) {
+				t.Errorf("expected: %#v\ngot: %#v", testCase.ExpectedFieldErrors, err.FieldErrors)
+			}
+
+			if string(err.Body) != testCase.Body {
+				t.Errorf("expected `%s` but got `%s`", testCase.Body, string(err.Body))
+			}
+		})
+	}
+}
+
+func TestAPIErrorString(t *testing.T) {
+	err := newAPIError(newTestResponse(http.StatusUnprocessableEntity, nil, `{"errors":{"src":["is invalid"],"event":["is invalid"]}}`))
+	expected := "shopify: 422 Unprocessable Entity: event is invalid, src is invalid"
+
+	if err.Error() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, err.Error())
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", newAPIError(newTestResponse(http.StatusNotFound, nil, `{"errors":"Not Found"}`)))
+
+	if !IsNotFound(err) {
+		t.Errorf("expected true")
+	}
+
+	if IsUnauthorized(err) || IsForbidden(err) || IsRateLimited(err) || IsUnprocessable(err) {
+		t.Errorf("expected false")
+	}
+
+	if !errors.Is(err, &APIError{StatusCode: http.StatusNotFound}) {
+		t.Errorf("expected true")
+	}
+
+	if errors.Is(err, &APIError{StatusCode: http.StatusTooManyRequests}) {
+		t.Errorf("expected false")
+	}
+
+	var apiErr *APIError
+
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected true")
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected %d but got %d", http.StatusNotFound, apiErr.StatusCode)
+	}
+}
@@ -0,0 +1,35 @@
+package shopify
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// APIVersion represents a Shopify Admin API version, such as `2024-07`.
+type APIVersion string
+
+const (
+	// DefaultAPIVersion is the API version used when neither the context nor
+	// the client specify one.
+	DefaultAPIVersion APIVersion = "2024-07"
+
+	// APIVersionUnstable is the unstable API version, which contains features
+	// that are still in development.
+	APIVersionUnstable APIVersion = "unstable"
+)
+
+const (
+	headerXShopifyAPIVersion          = "X-Shopify-API-Version"
+	headerXShopifyAPIDeprecatedReason = "X-Shopify-API-Deprecated-Reason"
+)
+
+var apiVersionRegexp = regexp.MustCompile(`^[0-9]{4}-(0[1-9]|1[0-2])$`)
+
+// Validate checks that the API version has a valid format.
+func (v APIVersion) Validate() error {
+	if v != APIVersionUnstable && !apiVersionRegexp.MatchString(string(v)) {
+		return fmt.Errorf("invalid API version `%s`", v)
+	}
+
+	return nil
+}
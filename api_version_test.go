@@ -0,0 +1,89 @@
+package shopify
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIVersionValidate(t *testing.T) {
+	testCases := []struct {
+		Version APIVersion
+		Valid   bool
+	}{
+		{"2024-07", true},
+		{"2019-10", true},
+		{"unstable", true},
+		{"", false},
+		{"2024-13", false},
+		{"2024-7", false},
+		{"latest", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.Version), func(t *testing.T) {
+			err := testCase.Version.Validate()
+
+			if testCase.Valid && err != nil {
+				t.Errorf("expected no error but got: %s", err)
+			}
+
+			if !testCase.Valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestAdminClientAPIVersion(t *testing.T) {
+	var path string
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		w.Header().Set(headerXShopifyAPIVersion, "2024-07")
+		w.Header().Set(headerXShopifyAPIDeprecatedReason, "https://shopify.dev/changelog")
+		w.Write([]byte(`{"count":0}`))
+	}))
+
+	output := &bytes.Buffer{}
+	client.Logger = log.New(output, "", 0)
+
+	if _, err := client.GetScriptTagsCount(ctx); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if expected := "/admin/api/2024-07/script_tags/count.json"; path != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, path)
+	}
+
+	if !strings.Contains(output.String(), "deprecated API call") {
+		t.Errorf("expected a deprecation to be logged but got: %s", output.String())
+	}
+
+	output.Reset()
+	client.APIVersion = "2019-10"
+
+	if _, err := client.GetScriptTagsCount(ctx); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if expected := "/admin/api/2019-10/script_tags/count.json"; path != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, path)
+	}
+
+	if !strings.Contains(output.String(), "served version `2024-07`") {
+		t.Errorf("expected a version mismatch to be logged but got: %s", output.String())
+	}
+
+	ctx = WithAPIVersion(ctx, APIVersionUnstable)
+
+	if _, err := client.GetScriptTagsCount(ctx); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if expected := "/admin/api/unstable/script_tags/count.json"; path != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, path)
+	}
+}
@@ -95,3 +95,40 @@ func (a *Application) NewAPIHandler(handler http.Handler) http.Handler {
 func (a *Application) NewAPIMiddleware() func(http.Handler) http.Handler {
 	return NewAPIMiddleware(a.OAuthTokenStorage)
 }
+
+// NewFulfillmentServiceHandler instantiates a new fulfillment service
+// handler.
+//
+// A typical usage is to serve the callback URL of a fulfillment service,
+// which Shopify calls to fetch stock levels and tracking numbers, and to
+// send fulfillment requests.
+func (a *Application) NewFulfillmentServiceHandler(provider FulfillmentServiceProvider) http.Handler {
+	return NewFulfillmentServiceHandler(provider, a.OAuthTokenStorage, a.Config, a.ErrorHandler)
+}
+
+// NewFulfillmentServiceMiddleware instantiates a new fulfillment service
+// middleware.
+//
+// A typical usage is to serve the callback URL of a fulfillment service,
+// which Shopify calls to fetch stock levels and tracking numbers, and to
+// send fulfillment requests.
+func (a *Application) NewFulfillmentServiceMiddleware(provider FulfillmentServiceProvider) func(http.Handler) http.Handler {
+	return NewFulfillmentServiceMiddleware(provider, a.OAuthTokenStorage, a.Config, a.ErrorHandler)
+}
+
+// NewCarrierServiceHandler instantiates a new carrier service handler.
+//
+// A typical usage is to serve the callback URL of a carrier service, which
+// Shopify calls to fetch real-time shipping rates during checkout.
+func (a *Application) NewCarrierServiceHandler(provider RateProvider, options *CarrierServiceOptions) http.Handler {
+	return NewCarrierServiceHandler(provider, a.Config, options, a.ErrorHandler)
+}
+
+// NewWebhookHandler instantiates a new webhook handler.
+//
+// A typical usage is to serve the address of the webhook subscriptions of the
+// application, and to dispatch the webhooks to the handlers registered on the
+// router.
+func (a *Application) NewWebhookHandler(router *WebhookRouter, options *WebhookOptions) http.Handler {
+	return NewWebhookHandler(router, a.Config, options, a.ErrorHandler)
+}
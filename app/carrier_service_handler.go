@@ -0,0 +1,252 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-shopify/shopify"
+)
+
+// DefaultRateRequestTimeout is the default time allowed to a RateProvider to
+// return shipping rates.
+//
+// Shopify waits at most 10 seconds for a carrier service to respond: the
+// default leaves some time to write the response.
+const DefaultRateRequestTimeout = 9 * time.Second
+
+// RateAddress represents the origin or the destination of a rate request.
+type RateAddress struct {
+	Country     string `json:"country"`
+	PostalCode  string `json:"postal_code"`
+	Province    string `json:"province"`
+	City        string `json:"city"`
+	Name        string `json:"name"`
+	Address1    string `json:"address1"`
+	Address2    string `json:"address2"`
+	Address3    string `json:"address3"`
+	Phone       string `json:"phone"`
+	Fax         string `json:"fax"`
+	Email       string `json:"email"`
+	AddressType string `json:"address_type"`
+	CompanyName string `json:"company_name"`
+}
+
+// RateItem represents an item to ship in a rate request.
+type RateItem struct {
+	Name               string                   `json:"name"`
+	SKU                string                   `json:"sku"`
+	Quantity           int                      `json:"quantity"`
+	Grams              int                      `json:"grams"`
+	Vendor             string                   `json:"vendor"`
+	RequiresShipping   bool                     `json:"requires_shipping"`
+	Taxable            bool                     `json:"taxable"`
+	FulfillmentService string                   `json:"fulfillment_service"`
+	ProductID          shopify.ProductID        `json:"product_id"`
+	VariantID          shopify.ProductVariantID `json:"variant_id"`
+	Properties         map[string]string        `json:"properties"`
+
+	// Price is the unit price of the item, in the minor unit of the
+	// currency (cents, for USD).
+	Price int64 `json:"price"`
+}
+
+// RateRequest represents a request from Shopify for the shipping rates of a
+// carrier service.
+type RateRequest struct {
+	// Shop is the shop that sent the request.
+	Shop shopify.Shop `json:"-"`
+
+	Origin      RateAddress          `json:"origin"`
+	Destination RateAddress          `json:"destination"`
+	Items       []RateItem           `json:"items"`
+	Currency    shopify.CurrencyCode `json:"currency"`
+	Locale      string               `json:"locale"`
+}
+
+// ShippingRate represents a shipping rate returned by a carrier service.
+type ShippingRate struct {
+	ServiceName string               `json:"service_name"`
+	ServiceCode string               `json:"service_code"`
+	Description string               `json:"description,omitempty"`
+	Currency    shopify.CurrencyCode `json:"currency"`
+
+	// TotalPrice is the price of the shipping rate, in the minor unit of the
+	// currency (cents, for USD).
+	TotalPrice int64 `json:"total_price,string"`
+
+	// PhoneRequired indicates whether the customer must provide a phone
+	// number.
+	PhoneRequired bool `json:"phone_required,omitempty"`
+
+	MinDeliveryDate *time.Time `json:"-"`
+	MaxDeliveryDate *time.Time `json:"-"`
+}
+
+// shippingRateTimeLayout is the layout of the delivery dates expected by
+// Shopify.
+const shippingRateTimeLayout = "2006-01-02 15:04:05 -0700"
+
+// MarshalJSON implements JSON marshalling.
+func (r ShippingRate) MarshalJSON() ([]byte, error) {
+	type shippingRate ShippingRate
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+
+		return t.Format(shippingRateTimeLayout)
+	}
+
+	return json.Marshal(struct {
+		shippingRate
+		MinDeliveryDate string `json:"min_delivery_date,omitempty"`
+		MaxDeliveryDate string `json:"max_delivery_date,omitempty"`
+	}{
+		shippingRate:    shippingRate(r),
+		MinDeliveryDate: formatTime(r.MinDeliveryDate),
+		MaxDeliveryDate: formatTime(r.MaxDeliveryDate),
+	})
+}
+
+// RateProvider represents a provider of real-time shipping rates.
+type RateProvider interface {
+	// GetRates returns the shipping rates for a request.
+	//
+	// The context expires when Shopify stops waiting for the response.
+	GetRates(ctx context.Context, req *RateRequest) ([]ShippingRate, error)
+}
+
+// CarrierServiceOptions represents the options of a carrier service handler.
+type CarrierServiceOptions struct {
+	// Timeout is the time allowed to the RateProvider to return shipping
+	// rates. If zero, DefaultRateRequestTimeout is used.
+	Timeout time.Duration
+
+	// FallbackRates are the rates returned when the RateProvider fails or
+	// times out. If empty, an error is returned to Shopify instead, which
+	// then uses the backup rates configured by the merchant, if any.
+	FallbackRates []ShippingRate
+}
+
+type carrierServiceHandlerImpl struct {
+	provider     RateProvider
+	options      CarrierServiceOptions
+	errorHandler ErrorHandler
+}
+
+// NewCarrierServiceHandler instantiates a new carrier service handler, to
+// serve at the callback URL of a carrier service.
+//
+// Requests are authenticated with the HMAC of their body. Options may be
+// nil, in which case the defaults apply.
+func NewCarrierServiceHandler(provider RateProvider, config *Config, options *CarrierServiceOptions, errorHandler ErrorHandler) http.Handler {
+	if provider == nil {
+		panic("A rate provider is required.")
+	}
+
+	if config == nil {
+		panic("A configuration is required.")
+	}
+
+	if options == nil {
+		options = &CarrierServiceOptions{}
+	}
+
+	h := carrierServiceHandlerImpl{
+		provider:     provider,
+		options:      *options,
+		errorHandler: errorHandler,
+	}
+
+	if h.options.Timeout <= 0 {
+		h.options.Timeout = DefaultRateRequestTimeout
+	}
+
+	return newBodyHMACHandler(h, config.APISecret, DefaultMaxCallbackBodySize)
+}
+
+func (h carrierServiceHandlerImpl) handleError(w http.ResponseWriter, req *http.Request, err error) {
+	if h.errorHandler != nil {
+		h.errorHandler.ServeHTTPError(w, req, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusInternalServerError)
+	fmt.Fprintf(w, "Internal server error: you may contact the application adminstrator.\n")
+}
+
+func (h carrierServiceHandlerImpl) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload := &struct {
+		Rate RateRequest `json:"rate"`
+	}{}
+
+	if err := json.NewDecoder(req.Body).Decode(payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Invalid rate request.")
+		return
+	}
+
+	rateRequest := &payload.Rate
+	rateRequest.Shop = shopify.Shop(req.Header.Get(headerXShopifyShopDomain))
+
+	ctx, cancel := context.WithTimeout(req.Context(), h.options.Timeout)
+	defer cancel()
+
+	if rateRequest.Shop != "" {
+		ctx = shopify.WithShop(ctx, rateRequest.Shop)
+	}
+
+	rates, err := h.getRates(ctx, rateRequest)
+
+	if err != nil {
+		if len(h.options.FallbackRates) == 0 {
+			h.handleError(w, req, fmt.Errorf("failed to get rates for `%s`: %s", rateRequest.Shop, err))
+			return
+		}
+
+		rates = h.options.FallbackRates
+	}
+
+	if rates == nil {
+		rates = []ShippingRate{}
+	}
+
+	writeJSON(w, struct {
+		Rates []ShippingRate `json:"rates"`
+	}{
+		Rates: rates,
+	})
+}
+
+// getRates calls the provider and returns as soon as the context expires,
+// even if the provider ignores it.
+func (h carrierServiceHandlerImpl) getRates(ctx context.Context, req *RateRequest) ([]ShippingRate, error) {
+	type result struct {
+		rates []ShippingRate
+		err   error
+	}
+
+	ch := make(chan result, 1)
+
+	go func() {
+		rates, err := h.provider.GetRates(ctx, req)
+		ch <- result{rates, err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.rates, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-shopify/shopify"
+)
+
+type testRateProvider func(ctx context.Context, req *RateRequest) ([]ShippingRate, error)
+
+func (p testRateProvider) GetRates(ctx context.Context, req *RateRequest) ([]ShippingRate, error) {
+	return p(ctx, req)
+}
+
+func newTestRateRequest(apiSecret shopify.APISecret) *http.Request {
+	body := `{"rate":{"origin":{"country":"CA","postal_code":"K2P1L4"},"destination":{"country":"CA","postal_code":"K1M1M4"},"items":[{"name":"Short Sleeve T-Shirt","sku":"","quantity":1,"grams":1000,"price":1999,"requires_shipping":true}],"currency":"CAD","locale":"en"}}`
+	req := httptest.NewRequest(http.MethodPost, "https://foo/rates", strings.NewReader(body))
+	req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC([]byte(body), apiSecret))
+	req.Header.Set(headerXShopifyShopDomain, "myshop.myshopify.com")
+
+	return req
+}
+
+func TestCarrierServiceHandler(t *testing.T) {
+	apiSecret := shopify.APISecret("abcdefgh")
+	config := &Config{APISecret: apiSecret}
+	fallbackRates := []ShippingRate{
+		{ServiceName: "Standard", ServiceCode: "STD", TotalPrice: 1000, Currency: "CAD"},
+	}
+
+	t.Run("rates", func(t *testing.T) {
+		date := time.Date(2013, 4, 12, 14, 48, 45, 0, time.FixedZone("", -4*3600))
+		handler := NewCarrierServiceHandler(testRateProvider(func(ctx context.Context, req *RateRequest) ([]ShippingRate, error) {
+			if req.Shop != "myshop.myshopify.com" || req.Items[0].Price != 1999 {
+				t.Errorf("unexpected rate request: %#v", req)
+			}
+
+			return []ShippingRate{
+				{ServiceName: "Express", ServiceCode: "EXP", TotalPrice: 1295, Currency: req.Currency, MinDeliveryDate: &date},
+			}, nil
+		}), config, &CarrierServiceOptions{FallbackRates: fallbackRates}, nil)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newTestRateRequest(apiSecret))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		expected := `{"rates":[{"service_name":"Express","service_code":"EXP","currency":"CAD","total_price":"1295","min_delivery_date":"2013-04-12 14:48:45 -0400"}]}`
+
+		if strings.TrimSpace(w.Body.String()) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, w.Body.String())
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		handler := NewCarrierServiceHandler(testRateProvider(func(ctx context.Context, req *RateRequest) ([]ShippingRate, error) {
+			time.Sleep(200 * time.Millisecond)
+
+			return nil, nil
+		}), config, &CarrierServiceOptions{Timeout: 10 * time.Millisecond, FallbackRates: fallbackRates}, nil)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newTestRateRequest(apiSecret))
+
+		expected := `{"rates":[{"service_name":"Standard","service_code":"STD","currency":"CAD","total_price":"1000"}]}`
+
+		if strings.TrimSpace(w.Body.String()) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, w.Body.String())
+		}
+	})
+
+	t.Run("error without fallback", func(t *testing.T) {
+		handler := NewCarrierServiceHandler(testRateProvider(func(ctx context.Context, req *RateRequest) ([]ShippingRate, error) {
+			return nil, context.DeadlineExceeded
+		}), config, nil, nil)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newTestRateRequest(apiSecret))
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected %d but got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+}
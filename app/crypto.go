@@ -1,12 +1,15 @@
 package app
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -16,6 +19,8 @@ import (
 
 const stateSize = 16
 
+const headerXShopifyHmacSHA256 = "X-Shopify-Hmac-Sha256"
+
 func generateRandomState() (string, error) {
 	var stateData [stateSize]byte
 
@@ -50,6 +55,22 @@ func injectHMAC(values url.Values, apiSecret shopify.APISecret) {
 	values.Set("hmac", hmac)
 }
 
+func computeBodyHMAC(body []byte, apiSecret shopify.APISecret) string {
+	hmac := hmac.New(sha256.New, []byte(apiSecret))
+	hmac.Write(body)
+	return base64.StdEncoding.EncodeToString(hmac.Sum(nil))
+}
+
+func verifyBodyHMAC(h string, body []byte, apiSecret shopify.APISecret) error {
+	expected := computeBodyHMAC(body, apiSecret)
+
+	if !hmac.Equal([]byte(h), []byte(expected)) {
+		return fmt.Errorf("HMAC verification failed: expected `%s` but got `%s`", expected, h)
+	}
+
+	return nil
+}
+
 func computeSignature(values url.Values, apiSecret shopify.APISecret) string {
 	s := values.Encode()
 	s, _ = url.QueryUnescape(s)
@@ -124,3 +145,46 @@ func newSignatureHandler(handler http.Handler, apiSecret shopify.APISecret) http
 		handler.ServeHTTP(w, req)
 	})
 }
+
+// newBodyHMACHandler wraps an existing handler and adds verification of the
+// HMAC of the request body, as sent by Shopify in the X-Shopify-Hmac-Sha256
+// header.
+//
+// Bodies larger than maxBodySize are rejected. The wrapped handler can read
+// the body again.
+func newBodyHMACHandler(handler http.Handler, apiSecret shopify.APISecret, maxBodySize int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hmac := req.Header.Get(headerXShopifyHmacSHA256)
+
+		if hmac == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Missing `%s` header.", headerXShopifyHmacSHA256)
+			return
+		}
+
+		// Read one more byte than allowed to detect larger bodies.
+		body, err := ioutil.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Failed to read the request body.")
+			return
+		}
+
+		if int64(len(body)) > maxBodySize {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			fmt.Fprintf(w, "The request body is too large.")
+			return
+		}
+
+		if err := verifyBodyHMAC(hmac, body, apiSecret); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "HMAC verification failed.")
+			return
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		handler.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,323 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-shopify/shopify"
+)
+
+// DefaultMaxCallbackBodySize is the default maximum size of the body of the
+// callbacks sent by Shopify.
+const DefaultMaxCallbackBodySize = 1 << 20
+
+const headerXShopifyShopDomain = "X-Shopify-Shop-Domain"
+
+// FetchStockRequest represents a request from Shopify for the stock levels
+// of a fulfillment service.
+type FetchStockRequest struct {
+	// Shop is the shop that sent the request.
+	Shop shopify.Shop
+
+	// SKU is the SKU to return the stock level of. If empty, the stock
+	// levels of all the SKUs must be returned.
+	SKU string
+
+	// MaxRetries is the number of times Shopify will retry the request on
+	// failure.
+	MaxRetries int
+}
+
+// FetchTrackingNumbersRequest represents a request from Shopify for the
+// tracking numbers of some orders.
+type FetchTrackingNumbersRequest struct {
+	// Shop is the shop that sent the request.
+	Shop shopify.Shop
+
+	// OrderNames are the names of the orders, such as "#1001".
+	OrderNames []string
+}
+
+// FulfillmentOrderNotificationKind represents the kind of a fulfillment order
+// notification.
+type FulfillmentOrderNotificationKind string
+
+const (
+	// FulfillmentOrderNotificationKindFulfillmentRequest indicates that
+	// fulfillment was requested for some fulfillment orders.
+	FulfillmentOrderNotificationKindFulfillmentRequest FulfillmentOrderNotificationKind = "FULFILLMENT_REQUEST"
+	// FulfillmentOrderNotificationKindCancellationRequest indicates that
+	// cancellation was requested for some fulfillment orders.
+	FulfillmentOrderNotificationKindCancellationRequest FulfillmentOrderNotificationKind = "CANCELLATION_REQUEST"
+)
+
+// FulfillmentOrderNotification represents a notification from Shopify that
+// some fulfillment orders assigned to a fulfillment service require action.
+//
+// The notification does not contain the fulfillment orders: use
+// shopify.AdminClient.GetAssignedFulfillmentOrders to fetch them.
+type FulfillmentOrderNotification struct {
+	// Shop is the shop that sent the notification.
+	Shop shopify.Shop `json:"-"`
+
+	// Kind is the kind of the notification.
+	Kind FulfillmentOrderNotificationKind `json:"kind"`
+}
+
+// FulfillmentServiceProvider represents the implementation of a fulfillment
+// service.
+//
+// The context passed to its methods contains the Shopify credentials of the
+// shop that sent the request, so that shopify.DefaultAdminClient can be used
+// directly.
+type FulfillmentServiceProvider interface {
+	// FetchStock returns the stock levels, indexed by SKU.
+	FetchStock(ctx context.Context, req *FetchStockRequest) (map[string]int, error)
+
+	// FetchTrackingNumbers returns the tracking numbers, indexed by order
+	// name.
+	FetchTrackingNumbers(ctx context.Context, req *FetchTrackingNumbersRequest) (map[string]string, error)
+
+	// AcceptFulfillmentRequest handles a fulfillment request, usually by
+	// fetching the assigned fulfillment orders and accepting or rejecting
+	// them.
+	AcceptFulfillmentRequest(ctx context.Context, req *FulfillmentOrderNotification) error
+}
+
+// FulfillmentCancellationProvider can optionally be implemented by a
+// FulfillmentServiceProvider to handle cancellation requests, which are
+// otherwise acknowledged and ignored.
+type FulfillmentCancellationProvider interface {
+	// AcceptCancellationRequest handles a cancellation request.
+	AcceptCancellationRequest(ctx context.Context, req *FulfillmentOrderNotification) error
+}
+
+type fulfillmentServiceHandlerImpl struct {
+	Config
+	provider     FulfillmentServiceProvider
+	storage      OAuthTokenStorage
+	errorHandler ErrorHandler
+}
+
+// NewFulfillmentServiceHandler instantiates a new fulfillment service
+// handler, to serve at the callback URL of a fulfillment service.
+//
+// The handler serves the `fetch_stock.json`, `fetch_tracking_numbers.json`
+// and `fulfillment_order_notification` callbacks, relative to the callback
+// URL, and dispatches them to the provider.
+//
+// The GET callbacks are authenticated with their `hmac` query parameter, and
+// the POST notifications with the HMAC of their body. The OAuth token of the
+// shop is loaded into the request context.
+func NewFulfillmentServiceHandler(provider FulfillmentServiceProvider, storage OAuthTokenStorage, config *Config, errorHandler ErrorHandler) http.Handler {
+	if provider == nil {
+		panic("A fulfillment service provider is required.")
+	}
+
+	if storage == nil {
+		panic("An OAuth token storage is required.")
+	}
+
+	if config == nil {
+		panic("A configuration is required.")
+	}
+
+	h := fulfillmentServiceHandlerImpl{
+		Config:       *config,
+		provider:     provider,
+		storage:      storage,
+		errorHandler: errorHandler,
+	}
+
+	queryHandler := newHMACHandler(h, h.APISecret)
+	bodyHandler := newBodyHMACHandler(h, h.APISecret, DefaultMaxCallbackBodySize)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// The body of GET callbacks is empty, so its HMAC would be the same
+		// for all the requests: only the query parameters can be trusted.
+		if req.Method == http.MethodPost {
+			bodyHandler.ServeHTTP(w, req)
+		} else {
+			queryHandler.ServeHTTP(w, req)
+		}
+	})
+}
+
+func (h fulfillmentServiceHandlerImpl) handleError(w http.ResponseWriter, req *http.Request, err error) {
+	if h.errorHandler != nil {
+		h.errorHandler.ServeHTTPError(w, req, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusInternalServerError)
+	fmt.Fprintf(w, "Internal server error: you may contact the application adminstrator.\n")
+}
+
+func (h fulfillmentServiceHandlerImpl) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	shop := shopify.Shop(req.URL.Query().Get("shop"))
+
+	// The query parameters of POST notifications are not signed.
+	if req.Method == http.MethodPost {
+		shop = shopify.Shop(req.Header.Get(headerXShopifyShopDomain))
+	}
+
+	if shop == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Missing `shop` parameter.")
+		return
+	}
+
+	oauthToken, err := h.storage.GetOAuthToken(req.Context(), shop)
+
+	if err != nil {
+		h.handleError(w, req, fmt.Errorf("failed to load OAuth token for `%s`: %s", shop, err))
+		return
+	}
+
+	if oauthToken == nil {
+		h.handleError(w, req, fmt.Errorf("no OAuth token for `%s`", shop))
+		return
+	}
+
+	req = req.WithContext(withSessionToken(req.Context(), &sessionToken{
+		Shop:       shop,
+		OAuthToken: *oauthToken,
+	}))
+
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/fetch_stock.json"):
+		h.serveFetchStock(w, req, shop)
+	case strings.HasSuffix(req.URL.Path, "/fetch_tracking_numbers.json"):
+		h.serveFetchTrackingNumbers(w, req, shop)
+	case strings.HasSuffix(req.URL.Path, "/fulfillment_order_notification"):
+		h.serveFulfillmentOrderNotification(w, req, shop)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (h fulfillmentServiceHandlerImpl) serveFetchStock(w http.ResponseWriter, req *http.Request, shop shopify.Shop) {
+	values := req.URL.Query()
+	maxRetries, _ := strconv.Atoi(values.Get("max_retries"))
+
+	stock, err := h.provider.FetchStock(req.Context(), &FetchStockRequest{
+		Shop:       shop,
+		SKU:        values.Get("sku"),
+		MaxRetries: maxRetries,
+	})
+
+	if err != nil {
+		h.handleError(w, req, fmt.Errorf("failed to fetch stock for `%s`: %s", shop, err))
+		return
+	}
+
+	if stock == nil {
+		stock = map[string]int{}
+	}
+
+	writeJSON(w, stock)
+}
+
+func (h fulfillmentServiceHandlerImpl) serveFetchTrackingNumbers(w http.ResponseWriter, req *http.Request, shop shopify.Shop) {
+	values := req.URL.Query()
+	orderNames := values["order_names[]"]
+
+	if len(orderNames) == 0 {
+		orderNames = values["order_names"]
+	}
+
+	trackingNumbers, err := h.provider.FetchTrackingNumbers(req.Context(), &FetchTrackingNumbersRequest{
+		Shop:       shop,
+		OrderNames: orderNames,
+	})
+
+	if err != nil {
+		h.handleError(w, req, fmt.Errorf("failed to fetch tracking numbers for `%s`: %s", shop, err))
+		return
+	}
+
+	if trackingNumbers == nil {
+		trackingNumbers = map[string]string{}
+	}
+
+	writeJSON(w, struct {
+		TrackingNumbers map[string]string `json:"tracking_numbers"`
+		Message         string            `json:"message"`
+		Success         bool              `json:"success"`
+	}{
+		TrackingNumbers: trackingNumbers,
+		Message:         "Successfully received the tracking numbers",
+		Success:         true,
+	})
+}
+
+func (h fulfillmentServiceHandlerImpl) serveFulfillmentOrderNotification(w http.ResponseWriter, req *http.Request, shop shopify.Shop) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	notification := &FulfillmentOrderNotification{}
+
+	if err := json.NewDecoder(req.Body).Decode(notification); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Invalid notification.")
+		return
+	}
+
+	notification.Shop = shop
+
+	var err error
+
+	switch notification.Kind {
+	case FulfillmentOrderNotificationKindFulfillmentRequest:
+		err = h.provider.AcceptFulfillmentRequest(req.Context(), notification)
+	case FulfillmentOrderNotificationKindCancellationRequest:
+		if provider, ok := h.provider.(FulfillmentCancellationProvider); ok {
+			err = provider.AcceptCancellationRequest(req.Context(), notification)
+		}
+	}
+
+	if err != nil {
+		h.handleError(w, req, fmt.Errorf("failed to handle `%s` notification for `%s`: %s", notification.Kind, shop, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(v)
+}
+
+// NewFulfillmentServiceMiddleware instantiates a new fulfillment service
+// middleware.
+//
+// Requests that are not fulfillment service callbacks are passed to the
+// next handler.
+func NewFulfillmentServiceMiddleware(provider FulfillmentServiceProvider, storage OAuthTokenStorage, config *Config, errorHandler ErrorHandler) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		callbacks := NewFulfillmentServiceHandler(provider, storage, config, errorHandler)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if isFulfillmentServiceCallback(req.URL.Path) {
+				callbacks.ServeHTTP(w, req)
+			} else {
+				handler.ServeHTTP(w, req)
+			}
+		})
+	}
+}
+
+func isFulfillmentServiceCallback(path string) bool {
+	return strings.HasSuffix(path, "/fetch_stock.json") ||
+		strings.HasSuffix(path, "/fetch_tracking_numbers.json") ||
+		strings.HasSuffix(path, "/fulfillment_order_notification")
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-shopify/shopify"
+)
+
+type testFulfillmentServiceProvider struct {
+	notifications []FulfillmentOrderNotification
+}
+
+func (p *testFulfillmentServiceProvider) FetchStock(ctx context.Context, req *FetchStockRequest) (map[string]int, error) {
+	if _, ok := shopify.GetAccessToken(ctx); !ok {
+		return nil, errors.New("missing credentials")
+	}
+
+	return map[string]int{req.SKU: 7}, nil
+}
+
+func (p *testFulfillmentServiceProvider) FetchTrackingNumbers(ctx context.Context, req *FetchTrackingNumbersRequest) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, name := range req.OrderNames {
+		result[name] = "tracking-" + name
+	}
+
+	return result, nil
+}
+
+func (p *testFulfillmentServiceProvider) AcceptFulfillmentRequest(ctx context.Context, req *FulfillmentOrderNotification) error {
+	p.notifications = append(p.notifications, *req)
+
+	return nil
+}
+
+func TestFulfillmentServiceHandler(t *testing.T) {
+	apiSecret := shopify.APISecret("abcdefgh")
+	shop := shopify.Shop("myshop.myshopify.com")
+	storage := &MemoryOAuthTokenStorage{}
+	storage.UpdateOAuthToken(context.Background(), shop, shopify.OAuthToken{AccessToken: "abc"})
+
+	provider := &testFulfillmentServiceProvider{}
+	handler := NewFulfillmentServiceHandler(provider, storage, &Config{APISecret: apiSecret}, nil)
+
+	t.Run("fetch stock", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		values := url.Values{"sku": {"ABC"}, "shop": {string(shop)}}
+		injectHMAC(values, apiSecret)
+		req := httptest.NewRequest(http.MethodGet, "https://foo/callback/fetch_stock.json?"+values.Encode(), nil)
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		if expected := `{"ABC":7}`; strings.TrimSpace(w.Body.String()) != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, w.Body.String())
+		}
+	})
+
+	t.Run("fetch tracking numbers", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		values := url.Values{"order_names[]": {"#1001"}, "shop": {string(shop)}}
+		injectHMAC(values, apiSecret)
+		req := httptest.NewRequest(http.MethodGet, "https://foo/callback/fetch_tracking_numbers.json?"+values.Encode(), nil)
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		if expected := `{"tracking_numbers":{"#1001":"tracking-#1001"},"message":"Successfully received the tracking numbers","success":true}`; strings.TrimSpace(w.Body.String()) != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, w.Body.String())
+		}
+	})
+
+	t.Run("fetch stock without hmac", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "https://foo/callback/fetch_stock.json?sku=ABC&shop="+string(shop), nil)
+		req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC(nil, apiSecret))
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected %d but got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("fetch stock with tampered parameters", func(t *testing.T) {
+		values := url.Values{"sku": {"ABC"}, "shop": {"other.myshopify.com"}}
+		injectHMAC(values, apiSecret)
+		values.Set("shop", string(shop))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "https://foo/callback/fetch_stock.json?"+values.Encode(), nil)
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected %d but got %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("fulfillment order notification", func(t *testing.T) {
+		body := `{"kind":"FULFILLMENT_REQUEST"}`
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "https://foo/callback/fulfillment_order_notification", strings.NewReader(body))
+		req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC([]byte(body), apiSecret))
+		req.Header.Set(headerXShopifyShopDomain, string(shop))
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		if len(provider.notifications) != 1 || provider.notifications[0].Shop != shop {
+			t.Errorf("unexpected notifications: %#v", provider.notifications)
+		}
+	})
+
+	t.Run("bad hmac", func(t *testing.T) {
+		body := `{"kind":"FULFILLMENT_REQUEST"}`
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "https://foo/callback/fulfillment_order_notification", strings.NewReader(body))
+		req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC([]byte("{}"), apiSecret))
+		req.Header.Set(headerXShopifyShopDomain, string(shop))
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected %d but got %d", http.StatusForbidden, w.Code)
+		}
+	})
+}
@@ -0,0 +1,316 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/go-shopify/shopify"
+)
+
+const (
+	headerXShopifyTopic      = "X-Shopify-Topic"
+	headerXShopifyWebhookID  = "X-Shopify-Webhook-Id"
+	headerXShopifyAPIVersion = "X-Shopify-API-Version"
+)
+
+type contextKey int
+
+const (
+	contextKeyWebhookTopic contextKey = iota
+	contextKeyWebhookID
+	contextKeyWebhookAPIVersion
+)
+
+// GetWebhookTopic returns the topic of the webhook being handled.
+func GetWebhookTopic(ctx context.Context) (shopify.WebhookTopic, bool) {
+	if v := ctx.Value(contextKeyWebhookTopic); v != nil {
+		return v.(shopify.WebhookTopic), true
+	}
+
+	return "", false
+}
+
+// GetWebhookID returns the ID of the webhook delivery being handled.
+//
+// Shopify may deliver a webhook more than once: the ID is the same for all
+// the deliveries and can be used to detect duplicates.
+func GetWebhookID(ctx context.Context) (string, bool) {
+	if v := ctx.Value(contextKeyWebhookID); v != nil {
+		return v.(string), true
+	}
+
+	return "", false
+}
+
+// GetWebhookAPIVersion returns the API version of the payload of the webhook
+// being handled.
+//
+// Unlike shopify.WithAPIVersion, it does not change the API version used by
+// the clients.
+func GetWebhookAPIVersion(ctx context.Context) (shopify.APIVersion, bool) {
+	if v := ctx.Value(contextKeyWebhookAPIVersion); v != nil {
+		return v.(shopify.APIVersion), true
+	}
+
+	return "", false
+}
+
+// WebhookHandler represents a handler for the webhooks of a topic.
+type WebhookHandler interface {
+	// ServeWebhook handles the raw payload of a webhook.
+	//
+	// If an error is returned, the delivery fails and Shopify retries it
+	// later.
+	ServeWebhook(ctx context.Context, payload []byte) error
+}
+
+// WebhookHandlerFunc is a function that implements WebhookHandler.
+type WebhookHandlerFunc func(ctx context.Context, payload []byte) error
+
+// ServeWebhook calls f(ctx, payload).
+func (f WebhookHandlerFunc) ServeWebhook(ctx context.Context, payload []byte) error {
+	return f(ctx, payload)
+}
+
+// WebhookRouter dispatches webhooks to handlers, according to their topic.
+//
+// The zero value is an empty router, ready to use.
+type WebhookRouter struct {
+	lock     sync.RWMutex
+	handlers map[shopify.WebhookTopic]WebhookHandler
+}
+
+// Handle registers the handler for a topic.
+//
+// Handle panics if a handler is already registered for the topic.
+func (r *WebhookRouter) Handle(topic shopify.WebhookTopic, handler WebhookHandler) {
+	if topic == "" {
+		panic("A webhook topic is required.")
+	}
+
+	if handler == nil {
+		panic("A webhook handler is required.")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.handlers[topic]; ok {
+		panic(fmt.Sprintf("A webhook handler is already registered for `%s`.", topic))
+	}
+
+	if r.handlers == nil {
+		r.handlers = map[shopify.WebhookTopic]WebhookHandler{}
+	}
+
+	r.handlers[topic] = handler
+}
+
+// HandleFunc registers the handler function for a topic.
+func (r *WebhookRouter) HandleFunc(topic shopify.WebhookTopic, handler func(ctx context.Context, payload []byte) error) {
+	r.Handle(topic, WebhookHandlerFunc(handler))
+}
+
+// Topics returns the topics that have a registered handler, for instance to
+// subscribe to them with shopify.AdminClient.EnsureWebhooks.
+func (r *WebhookRouter) Topics() []shopify.WebhookTopic {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	topics := make([]shopify.WebhookTopic, 0, len(r.handlers))
+
+	for topic := range r.handlers {
+		topics = append(topics, topic)
+	}
+
+	sort.Slice(topics, func(i, j int) bool { return topics[i] < topics[j] })
+
+	return topics
+}
+
+func (r *WebhookRouter) handler(topic shopify.WebhookTopic) WebhookHandler {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.handlers[topic]
+}
+
+// HandlePayload registers a typed handler for a topic, which receives the
+// decoded payload of the webhooks, for instance:
+//
+//	app.HandlePayload(router, shopify.WebhookTopicOrdersCreate, func(ctx context.Context, order *shopify.Order) error {
+//		...
+//	})
+//
+// If the topic has a payload type (see shopify.WebhookTopic.PayloadType), T
+// must be that type. Otherwise, T may be any type the payload can be decoded
+// into. Payloads that cannot be decoded are rejected with a 400 status, so
+// that Shopify does not retry them.
+//
+// HandlePayload panics if T is not the payload type of the topic.
+func HandlePayload[T any](r *WebhookRouter, topic shopify.WebhookTopic, handler func(ctx context.Context, payload *T) error) {
+	if handler == nil {
+		panic("A webhook handler is required.")
+	}
+
+	payloadType := reflect.TypeOf((*T)(nil)).Elem()
+
+	if expected := topic.PayloadType(); expected != nil && payloadType != expected {
+		panic(fmt.Sprintf("The payload of `%s` webhooks is a `%s`, not a `%s`.", topic, expected, payloadType))
+	}
+
+	r.HandleFunc(topic, func(ctx context.Context, data []byte) error {
+		payload := new(T)
+
+		if err := json.Unmarshal(data, payload); err != nil {
+			return &invalidPayloadError{err: err}
+		}
+
+		return handler(ctx, payload)
+	})
+}
+
+// invalidPayloadError is returned by the handlers when a payload cannot be
+// decoded. Retrying such webhooks is pointless.
+type invalidPayloadError struct {
+	err error
+}
+
+func (e *invalidPayloadError) Error() string {
+	return fmt.Sprintf("failed to decode payload: %s", e.err)
+}
+
+func (e *invalidPayloadError) Unwrap() error {
+	return e.err
+}
+
+// WebhookOptions represents the options of a webhook handler.
+type WebhookOptions struct {
+	// MaxBodySize is the maximum size of the webhook payloads. If zero,
+	// DefaultMaxCallbackBodySize is used.
+	MaxBodySize int64
+}
+
+type webhookHandlerImpl struct {
+	router       *WebhookRouter
+	errorHandler ErrorHandler
+}
+
+// NewWebhookHandler instantiates a new webhook handler, to serve at the
+// address of webhook subscriptions.
+//
+// Requests are authenticated with the HMAC of their body, and dispatched to
+// the handler registered on the router for their topic. Webhooks whose topic
+// has no registered handler are acknowledged and ignored. Handler errors
+// result in a 500 status, so that Shopify retries the webhook, except for
+// payloads that HandlePayload cannot decode, which result in a 400 status.
+//
+// The context passed to the handlers references the shop that sent the
+// webhook, which can be retrieved with shopify.GetShop, as well as the topic,
+// ID and API version of the webhook. It contains no credentials: webhooks
+// such as "app/uninstalled" are sent after the OAuth token was revoked.
+//
+// Options may be nil, in which case the defaults apply.
+func NewWebhookHandler(router *WebhookRouter, config *Config, options *WebhookOptions, errorHandler ErrorHandler) http.Handler {
+	if router == nil {
+		panic("A webhook router is required.")
+	}
+
+	if config == nil {
+		panic("A configuration is required.")
+	}
+
+	if options == nil {
+		options = &WebhookOptions{}
+	}
+
+	maxBodySize := options.MaxBodySize
+
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxCallbackBodySize
+	}
+
+	h := webhookHandlerImpl{
+		router:       router,
+		errorHandler: errorHandler,
+	}
+
+	return newBodyHMACHandler(h, config.APISecret, maxBodySize)
+}
+
+func (h webhookHandlerImpl) handleError(w http.ResponseWriter, req *http.Request, err error) {
+	if h.errorHandler != nil {
+		h.errorHandler.ServeHTTPError(w, req, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusInternalServerError)
+	fmt.Fprintf(w, "Internal server error: you may contact the application adminstrator.\n")
+}
+
+func (h webhookHandlerImpl) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprintf(w, "Webhooks must be sent with POST.")
+		return
+	}
+
+	topic := shopify.WebhookTopic(req.Header.Get(headerXShopifyTopic))
+
+	if topic == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Missing `%s` header.", headerXShopifyTopic)
+		return
+	}
+
+	shop := shopify.Shop(req.Header.Get(headerXShopifyShopDomain))
+
+	if shop == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Missing `%s` header.", headerXShopifyShopDomain)
+		return
+	}
+
+	handler := h.router.handler(topic)
+
+	if handler == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// The body was already read, and size-limited, by the HMAC handler.
+	payload, err := ioutil.ReadAll(req.Body)
+
+	if err != nil {
+		h.handleError(w, req, fmt.Errorf("failed to read webhook payload: %s", err))
+		return
+	}
+
+	ctx := shopify.WithShop(req.Context(), shop)
+	ctx = context.WithValue(ctx, contextKeyWebhookTopic, topic)
+	ctx = context.WithValue(ctx, contextKeyWebhookID, req.Header.Get(headerXShopifyWebhookID))
+	ctx = context.WithValue(ctx, contextKeyWebhookAPIVersion, shopify.APIVersion(req.Header.Get(headerXShopifyAPIVersion)))
+
+	if err := handler.ServeWebhook(ctx, payload); err != nil {
+		var payloadErr *invalidPayloadError
+
+		if errors.As(err, &payloadErr) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Invalid `%s` payload.", topic)
+			return
+		}
+
+		h.handleError(w, req.WithContext(ctx), fmt.Errorf("failed to handle `%s` webhook from `%s`: %w", topic, shop, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
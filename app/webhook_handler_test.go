@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-shopify/shopify"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	apiSecret := shopify.APISecret("abcdefgh")
+	router := &WebhookRouter{}
+
+	var received []string
+
+	router.HandleFunc("orders/create", func(ctx context.Context, payload []byte) error {
+		shop, _ := shopify.GetShop(ctx)
+		topic, _ := GetWebhookTopic(ctx)
+		id, _ := GetWebhookID(ctx)
+		version, _ := GetWebhookAPIVersion(ctx)
+
+		received = append(received, strings.Join([]string{string(shop), string(topic), id, string(version), string(payload)}, " "))
+
+		return nil
+	})
+	router.HandleFunc("app/uninstalled", func(ctx context.Context, payload []byte) error {
+		return errors.New("storage unavailable")
+	})
+
+	var products []shopify.Product
+
+	HandlePayload(router, shopify.WebhookTopicProductsUpdate, func(ctx context.Context, product *shopify.Product) error {
+		products = append(products, *product)
+
+		return nil
+	})
+
+	handler := NewWebhookHandler(router, &Config{APISecret: apiSecret}, &WebhookOptions{MaxBodySize: 64}, nil)
+
+	newRequest := func(topic string, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "https://foo/webhooks", strings.NewReader(body))
+		req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC([]byte(body), apiSecret))
+		req.Header.Set(headerXShopifyTopic, topic)
+		req.Header.Set(headerXShopifyShopDomain, "myshop.myshopify.com")
+		req.Header.Set(headerXShopifyWebhookID, "b54557e4")
+		req.Header.Set(headerXShopifyAPIVersion, "2021-01")
+
+		return req
+	}
+
+	t.Run("dispatch", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("orders/create", `{"id":1}`))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		expected := `myshop.myshopify.com orders/create b54557e4 2021-01 {"id":1}`
+
+		if len(received) != 1 || received[0] != expected {
+			t.Errorf("expected `%s` but got `%v`", expected, received)
+		}
+	})
+
+	t.Run("payload", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("products/update", `{"id":2,"title":"Tee"}`))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		if len(products) != 1 || products[0].ID != 2 || products[0].Title != "Tee" {
+			t.Errorf("unexpected products: %#v", products)
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("products/update", `[]`))
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected %d but got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("unknown topic", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("shop/update", `{"id":2}`))
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected %d but got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("app/uninstalled", `{}`))
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected %d but got %d: %s", http.StatusInternalServerError, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("invalid HMAC", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := newRequest("orders/create", `{"id":1}`)
+		req.Header.Set(headerXShopifyHmacSHA256, computeBodyHMAC([]byte(`{"id":3}`), apiSecret))
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected %d but got %d: %s", http.StatusForbidden, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("orders/create", `{"note":"`+strings.Repeat("a", 64)+`"}`))
+
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected %d but got %d: %s", http.StatusRequestEntityTooLarge, w.Code, w.Body.String())
+		}
+	})
+
+	if expected := "[app/uninstalled orders/create products/update]"; fmt.Sprint(router.Topics()) != expected {
+		t.Errorf("expected `%s` but got `%v`", expected, router.Topics())
+	}
+}
+
+func TestWebhookRouterHandlePayloadMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+
+	router := &WebhookRouter{}
+	HandlePayload(router, shopify.WebhookTopicOrdersCreate, func(ctx context.Context, product *shopify.Product) error {
+		return nil
+	})
+}
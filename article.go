@@ -0,0 +1,194 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ArticleID is an ID of an article.
+type ArticleID int
+
+// ArticleImage represents the image of an article.
+type ArticleImage struct {
+	Src        string     `json:"src,omitempty"`
+	Alt        string     `json:"alt,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	Height     int        `json:"height,omitempty"`
+	Attachment []byte     `json:"attachment,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// Article represents an article of a blog.
+//
+// Empty fields are omitted when creating or updating an article, so that
+// only the specified fields are changed.
+type Article struct {
+	ID                ArticleID     `json:"id,omitempty"`
+	BlogID            BlogID        `json:"blog_id,omitempty"`
+	Title             string        `json:"title,omitempty"`
+	Handle            string        `json:"handle,omitempty"`
+	Author            string        `json:"author,omitempty"`
+	BodyHTML          string        `json:"body_html,omitempty"`
+	SummaryHTML       string        `json:"summary_html,omitempty"`
+	Tags              string        `json:"tags,omitempty"`
+	TemplateSuffix    string        `json:"template_suffix,omitempty"`
+	Image             *ArticleImage `json:"image,omitempty"`
+	UserID            int           `json:"user_id,omitempty"`
+	AdminGraphQLAPIID string        `json:"admin_graphql_api_id,omitempty"`
+	PublishedAt       *time.Time    `json:"published_at,omitempty"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time    `json:"updated_at,omitempty"`
+
+	// Published can be set when creating or updating an article, to publish
+	// or hide it. It is never returned: see PublishedAt.
+	Published *bool `json:"published,omitempty"`
+}
+
+type articleEnvelope struct {
+	Article Article `json:"article"`
+}
+
+// ArticleFilter represents filters to apply when listing articles.
+type ArticleFilter struct {
+	Author          string
+	Handle          string
+	Tag             string
+	PublishedStatus string
+	CreatedAtMin    time.Time
+	CreatedAtMax    time.Time
+	UpdatedAtMin    time.Time
+	UpdatedAtMax    time.Time
+	PublishedAtMin  time.Time
+	PublishedAtMax  time.Time
+}
+
+func (f *ArticleFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	setString(values, "author", f.Author)
+	setString(values, "handle", f.Handle)
+	setString(values, "tag", f.Tag)
+	setString(values, "published_status", f.PublishedStatus)
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "published_at_min", f.PublishedAtMin)
+	setTime(values, "published_at_max", f.PublishedAtMax)
+}
+
+// GetAllArticles retrieves a list of all the articles of a blog matching the
+// filter.
+func (c *AdminClient) GetAllArticles(ctx context.Context, blogID BlogID, filter *ArticleFilter, fields SelectedFields) ([]Article, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Article
+
+	for page := 1; pagination != nil; page++ {
+		articles, links, err := c.GetArticles(ctx, blogID, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, articles...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetArticles retrieves a list of the articles of a blog matching the
+// filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllArticles.
+func (c *AdminClient) GetArticles(ctx context.Context, blogID BlogID, filter *ArticleFilter, pagination *Pagination, fields SelectedFields) ([]Article, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Articles []Article `json:"articles"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("blogs/%d/articles.json", blogID), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Articles, parsePageLinks(header), nil
+}
+
+// GetArticlesCount retrieves the count of all the articles of a blog
+// matching the filter.
+func (c *AdminClient) GetArticlesCount(ctx context.Context, blogID BlogID, filter *ArticleFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, fmt.Sprintf("blogs/%d/articles/count.json", blogID), values)
+}
+
+// GetArticle fetches an article of a blog by ID.
+//
+// If no such article exists, a nil article and no error is returned.
+func (c *AdminClient) GetArticle(ctx context.Context, blogID BlogID, id ArticleID, fields SelectedFields) (*Article, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &articleEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("blogs/%d/articles/%d.json", blogID, id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Article, nil
+}
+
+// CreateArticle creates an article in a blog.
+func (c *AdminClient) CreateArticle(ctx context.Context, blogID BlogID, article Article) (*Article, error) {
+	body := &articleEnvelope{Article: article}
+	result := &articleEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("blogs/%d/articles.json", blogID), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Article, nil
+}
+
+// UpdateArticle updates an article of a blog.
+func (c *AdminClient) UpdateArticle(ctx context.Context, blogID BlogID, article Article) (*Article, error) {
+	body := &articleEnvelope{Article: article}
+	result := &articleEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("blogs/%d/articles/%d.json", blogID, article.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Article, nil
+}
+
+// DeleteArticle deletes an article of a blog.
+func (c *AdminClient) DeleteArticle(ctx context.Context, blogID BlogID, id ArticleID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("blogs/%d/articles/%d.json", blogID, id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
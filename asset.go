@@ -0,0 +1,110 @@
+package shopify
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Asset represents a file of a theme, such as a template, a stylesheet or an
+// image.
+//
+// Text assets have their content in Value and binary assets in Attachment.
+// Assets are only returned with their content when fetched one by one.
+type Asset struct {
+	Key         string     `json:"key"`
+	Value       string     `json:"value,omitempty"`
+	Attachment  []byte     `json:"attachment,omitempty"`
+	ThemeID     ThemeID    `json:"theme_id,omitempty"`
+	ContentType string     `json:"content_type,omitempty"`
+	Size        int        `json:"size,omitempty"`
+	PublicURL   string     `json:"public_url,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Checksum is the hex-encoded MD5 checksum of the content. See
+	// AssetChecksum.
+	Checksum string `json:"checksum,omitempty"`
+
+	// Src can be set when putting an asset, to upload its content from a
+	// URL. It is never returned.
+	Src string `json:"src,omitempty"`
+
+	// SourceKey can be set when putting an asset, to copy the content of
+	// another asset of the theme. It is never returned.
+	SourceKey string `json:"source_key,omitempty"`
+}
+
+type assetEnvelope struct {
+	Asset Asset `json:"asset"`
+}
+
+// AssetChecksum returns the checksum of some asset content, as computed by
+// Shopify.
+func AssetChecksum(content []byte) string {
+	sum := md5.Sum(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// GetAssets retrieves the list of all the assets of a theme, without their
+// content.
+func (c *AdminClient) GetAssets(ctx context.Context, themeID ThemeID) ([]Asset, error) {
+	result := &struct {
+		Assets []Asset `json:"assets"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("themes/%d/assets.json", themeID), nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Assets, nil
+}
+
+// GetAsset fetches an asset of a theme by key, along with its content.
+//
+// If no such asset exists, a nil asset and no error is returned.
+func (c *AdminClient) GetAsset(ctx context.Context, themeID ThemeID, key string) (*Asset, error) {
+	values := url.Values{}
+	values.Set("asset[key]", key)
+
+	result := &assetEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("themes/%d/assets.json", themeID), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Asset, nil
+}
+
+// PutAsset creates or replaces an asset of a theme.
+//
+// Exactly one of Value, Attachment, Src or SourceKey must be set.
+func (c *AdminClient) PutAsset(ctx context.Context, themeID ThemeID, asset Asset) (*Asset, error) {
+	body := &assetEnvelope{Asset: asset}
+	result := &assetEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("themes/%d/assets.json", themeID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Asset, nil
+}
+
+// DeleteAsset deletes an asset of a theme.
+func (c *AdminClient) DeleteAsset(ctx context.Context, themeID ThemeID, key string) error {
+	values := url.Values{}
+	values.Set("asset[key]", key)
+
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("themes/%d/assets.json", themeID), values, nil, http.StatusOK, nil)
+
+	return err
+}
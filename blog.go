@@ -0,0 +1,148 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BlogID is an ID of a blog.
+type BlogID int
+
+// BlogCommentable represents whether readers can comment on the articles of
+// a blog.
+type BlogCommentable string
+
+const (
+	// BlogCommentableNo indicates that comments are disabled.
+	BlogCommentableNo BlogCommentable = "no"
+	// BlogCommentableModerate indicates that comments must be approved
+	// before being published.
+	BlogCommentableModerate BlogCommentable = "moderate"
+	// BlogCommentableYes indicates that comments are published immediately.
+	BlogCommentableYes BlogCommentable = "yes"
+)
+
+// Blog represents a blog of the online store.
+//
+// Empty fields are omitted when creating or updating a blog, so that only
+// the specified fields are changed.
+type Blog struct {
+	ID                 BlogID          `json:"id,omitempty"`
+	Title              string          `json:"title,omitempty"`
+	Handle             string          `json:"handle,omitempty"`
+	Commentable        BlogCommentable `json:"commentable,omitempty"`
+	Feedburner         string          `json:"feedburner,omitempty"`
+	FeedburnerLocation string          `json:"feedburner_location,omitempty"`
+	TemplateSuffix     string          `json:"template_suffix,omitempty"`
+	Tags               string          `json:"tags,omitempty"`
+	AdminGraphQLAPIID  string          `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt          *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time      `json:"updated_at,omitempty"`
+}
+
+type blogEnvelope struct {
+	Blog Blog `json:"blog"`
+}
+
+// GetAllBlogs retrieves a list of all the blogs.
+func (c *AdminClient) GetAllBlogs(ctx context.Context, fields SelectedFields) ([]Blog, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Blog
+
+	for page := 1; pagination != nil; page++ {
+		blogs, links, err := c.GetBlogs(ctx, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, blogs...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetBlogs retrieves a list of blogs.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllBlogs.
+func (c *AdminClient) GetBlogs(ctx context.Context, pagination *Pagination, fields SelectedFields) ([]Blog, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Blogs []Blog `json:"blogs"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "blogs.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Blogs, parsePageLinks(header), nil
+}
+
+// GetBlogsCount retrieves the count of all the blogs.
+func (c *AdminClient) GetBlogsCount(ctx context.Context) (int, error) {
+	return c.getCount(ctx, "blogs/count.json", nil)
+}
+
+// GetBlog fetches a blog by ID.
+//
+// If no such blog exists, a nil blog and no error is returned.
+func (c *AdminClient) GetBlog(ctx context.Context, id BlogID, fields SelectedFields) (*Blog, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &blogEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("blogs/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Blog, nil
+}
+
+// CreateBlog creates a blog.
+func (c *AdminClient) CreateBlog(ctx context.Context, blog Blog) (*Blog, error) {
+	body := &blogEnvelope{Blog: blog}
+	result := &blogEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "blogs.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Blog, nil
+}
+
+// UpdateBlog updates a blog.
+func (c *AdminClient) UpdateBlog(ctx context.Context, blog Blog) (*Blog, error) {
+	body := &blogEnvelope{Blog: blog}
+	result := &blogEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("blogs/%d.json", blog.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Blog, nil
+}
+
+// DeleteBlog deletes a blog, along with its articles.
+func (c *AdminClient) DeleteBlog(ctx context.Context, id BlogID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("blogs/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,414 @@
+package shopify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// BulkOperationID is the global ID of a bulk operation.
+type BulkOperationID string
+
+// BulkOperationStatus represents the status of a bulk operation.
+type BulkOperationStatus string
+
+const (
+	// BulkOperationStatusCreated indicates that the operation was created.
+	BulkOperationStatusCreated BulkOperationStatus = "CREATED"
+	// BulkOperationStatusRunning indicates that the operation is running.
+	BulkOperationStatusRunning BulkOperationStatus = "RUNNING"
+	// BulkOperationStatusCompleted indicates that the operation completed
+	// successfully.
+	BulkOperationStatusCompleted BulkOperationStatus = "COMPLETED"
+	// BulkOperationStatusCanceling indicates that the operation is being
+	// canceled.
+	BulkOperationStatusCanceling BulkOperationStatus = "CANCELING"
+	// BulkOperationStatusCanceled indicates that the operation was canceled.
+	BulkOperationStatusCanceled BulkOperationStatus = "CANCELED"
+	// BulkOperationStatusFailed indicates that the operation failed.
+	BulkOperationStatusFailed BulkOperationStatus = "FAILED"
+	// BulkOperationStatusExpired indicates that the results of the operation
+	// are no longer available.
+	BulkOperationStatusExpired BulkOperationStatus = "EXPIRED"
+)
+
+// IsFinal returns true if the status is a final one, that will never change.
+func (s BulkOperationStatus) IsFinal() bool {
+	switch s {
+	case BulkOperationStatusCompleted, BulkOperationStatusCanceled, BulkOperationStatusFailed, BulkOperationStatusExpired:
+		return true
+	}
+
+	return false
+}
+
+// BulkOperation represents a bulk operation.
+type BulkOperation struct {
+	ID             BulkOperationID     `json:"id"`
+	Status         BulkOperationStatus `json:"status"`
+	ErrorCode      string              `json:"errorCode"`
+	ObjectCount    int64               `json:"objectCount,string"`
+	URL            string              `json:"url"`
+	PartialDataURL string              `json:"partialDataUrl"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	CompletedAt    *time.Time          `json:"completedAt"`
+}
+
+const bulkOperationFields = `id status errorCode objectCount url partialDataUrl createdAt completedAt`
+
+// BulkQueryOptions represents the options of a bulk query.
+type BulkQueryOptions struct {
+	// MinPollInterval is the delay before the first poll of the bulk
+	// operation status.
+	//
+	// The delay doubles after each poll. If zero, one second is used.
+	MinPollInterval time.Duration
+
+	// MaxPollInterval is the maximum delay between two polls.
+	//
+	// If zero, thirty seconds are used.
+	MaxPollInterval time.Duration
+}
+
+func (o *BulkQueryOptions) pollPolicy() *RetryPolicy {
+	policy := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+		Jitter:     0.1,
+	}
+
+	if o != nil && o.MinPollInterval != 0 {
+		policy.MinBackoff = o.MinPollInterval
+	}
+
+	if o != nil && o.MaxPollInterval != 0 {
+		policy.MaxBackoff = o.MaxPollInterval
+	}
+
+	return policy
+}
+
+// RunBulkQuery submits a bulk query, waits for it to complete and returns a
+// reader over its results, which are usually iterated with
+// BulkObjectReader.All.
+//
+// The caller must close the returned reader.
+func (c *GraphQLClient) RunBulkQuery(ctx context.Context, query string, options *BulkQueryOptions) (*BulkObjectReader, error) {
+	operation, err := c.SubmitBulkQuery(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if operation, err = c.WaitBulkOperation(ctx, operation.ID, options); err != nil {
+		return nil, err
+	}
+
+	return c.OpenBulkOperationResult(ctx, operation)
+}
+
+// SubmitBulkQuery submits a bulk query and returns the created bulk
+// operation, without waiting for it to complete.
+func (c *GraphQLClient) SubmitBulkQuery(ctx context.Context, query string) (*BulkOperation, error) {
+	result := struct {
+		BulkOperationRunQuery struct {
+			BulkOperation *BulkOperation `json:"bulkOperation"`
+		} `json:"bulkOperationRunQuery"`
+	}{}
+
+	mutation := `mutation($query: String!) { bulkOperationRunQuery(query: $query) { bulkOperation { ` + bulkOperationFields + ` } userErrors { field message } } }`
+
+	if err := c.Do(ctx, mutation, map[string]interface{}{"query": query}, &result); err != nil {
+		return nil, fmt.Errorf("failed to submit bulk query: %w", err)
+	}
+
+	if result.BulkOperationRunQuery.BulkOperation == nil {
+		return nil, errors.New("failed to submit bulk query: no bulk operation was returned")
+	}
+
+	return result.BulkOperationRunQuery.BulkOperation, nil
+}
+
+// GetBulkOperation fetches a bulk operation by ID.
+func (c *GraphQLClient) GetBulkOperation(ctx context.Context, id BulkOperationID) (*BulkOperation, error) {
+	result := struct {
+		Node *BulkOperation `json:"node"`
+	}{}
+
+	query := `query($id: ID!) { node(id: $id) { ... on BulkOperation { ` + bulkOperationFields + ` } } }`
+
+	if err := c.Do(ctx, query, map[string]interface{}{"id": id}, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Node == nil {
+		return nil, fmt.Errorf("no bulk operation with ID `%s`", id)
+	}
+
+	return result.Node, nil
+}
+
+// WaitBulkOperation polls a bulk operation until it reaches a final status.
+//
+// The operation is polled by ID, with GetBulkOperation, rather than with
+// `currentBulkOperation`: the latter returns the last operation started by
+// the app on the shop, which is another one if a concurrent caller started
+// one in the meantime.
+//
+// If the operation does not complete successfully, an error is returned.
+func (c *GraphQLClient) WaitBulkOperation(ctx context.Context, id BulkOperationID, options *BulkQueryOptions) (*BulkOperation, error) {
+	policy := options.pollPolicy()
+
+	for attempt := 1; ; attempt++ {
+		operation, err := c.GetBulkOperation(ctx, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to poll bulk operation: %w", err)
+		}
+
+		switch operation.Status {
+		case BulkOperationStatusCompleted:
+			return operation, nil
+		case BulkOperationStatusCanceled, BulkOperationStatusFailed, BulkOperationStatusExpired:
+			if operation.ErrorCode != "" {
+				return operation, fmt.Errorf("bulk operation `%s` ended with status %s (%s)", id, operation.Status, operation.ErrorCode)
+			}
+
+			return operation, fmt.Errorf("bulk operation `%s` ended with status %s", id, operation.Status)
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// OpenBulkOperationResult downloads the results of a completed bulk
+// operation and returns a reader over them.
+//
+// The caller must close the returned reader.
+func (c *GraphQLClient) OpenBulkOperationResult(ctx context.Context, operation *BulkOperation) (*BulkObjectReader, error) {
+	// Operations that matched no object have no result file.
+	if operation.URL == "" {
+		return NewBulkObjectReader(http.NoBody), nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, operation.URL, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %s", err)
+	}
+
+	req = req.WithContext(ctx)
+
+	// The URL is signed: no credentials must be sent.
+	resp, err := c.adminClient().httpClient().Do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer flushAndCloseBody(resp.Body)
+
+		return nil, newAPIError(resp)
+	}
+
+	return NewBulkObjectReader(resp.Body), nil
+}
+
+// BulkObject represents an object returned by a bulk query, along with its
+// nested objects.
+type BulkObject struct {
+	// ID is the global ID of the object.
+	ID string
+
+	// ParentID is the global ID of the parent object, if any.
+	ParentID string
+
+	// Data is the raw JSON object, as returned by Shopify.
+	Data json.RawMessage
+
+	// Children contains the objects nested in this one, in order.
+	Children []*BulkObject
+}
+
+// Decode decodes the JSON object into v.
+func (o *BulkObject) Decode(v interface{}) error {
+	return json.Unmarshal(o.Data, v)
+}
+
+// BulkObjectReader reads the JSONL results of a bulk query.
+//
+// Shopify flattens nested connections in the results, using a `__parentId`
+// field to reference the parent object of a nested object. A
+// BulkObjectReader reassembles them, so that each top-level object is
+// returned with its nested objects.
+//
+// The objects are usually iterated with All:
+//
+//	for object, err := range reader.All() {
+//		if err != nil {
+//			...
+//		}
+//		...
+//	}
+//
+// A BulkObjectReader can also be used like a bufio.Scanner, with Next,
+// Object and Err.
+type BulkObjectReader struct {
+	body    io.ReadCloser
+	reader  *bufio.Reader
+	current *BulkObject
+	pending *BulkObject
+	index   map[string]*BulkObject
+	err     error
+	done    bool
+}
+
+// NewBulkObjectReader instantiates a reader over JSONL bulk query results.
+func NewBulkObjectReader(r io.ReadCloser) *BulkObjectReader {
+	return &BulkObjectReader{
+		body:   r,
+		reader: bufio.NewReader(r),
+	}
+}
+
+// readObject reads the next object in the stream, returning nil at the end.
+func (r *BulkObjectReader) readObject() (*BulkObject, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+
+		if len(line) > 0 {
+			header := struct {
+				ID       string `json:"id"`
+				ParentID string `json:"__parentId"`
+			}{}
+
+			if err := json.Unmarshal(line, &header); err != nil {
+				return nil, fmt.Errorf("failed to parse bulk object: %s", err)
+			}
+
+			return &BulkObject{
+				ID:       header.ID,
+				ParentID: header.ParentID,
+				Data:     json.RawMessage(line),
+			}, nil
+		}
+
+		if err == io.EOF {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Next advances the reader to the next top-level object, which is then
+// available through Object.
+//
+// It returns false at the end of the results or if an error occurs.
+func (r *BulkObjectReader) Next() bool {
+	r.current = nil
+
+	if r.err != nil || (r.done && r.pending == nil) {
+		return false
+	}
+
+	for !r.done {
+		object, err := r.readObject()
+
+		if err != nil {
+			r.err = err
+			return false
+		}
+
+		if object == nil {
+			r.done = true
+			break
+		}
+
+		if object.ParentID == "" {
+			previous := r.pending
+			r.pending = object
+			r.index = map[string]*BulkObject{}
+
+			if object.ID != "" {
+				r.index[object.ID] = object
+			}
+
+			if previous != nil {
+				r.current = previous
+				return true
+			}
+
+			continue
+		}
+
+		parent, ok := r.index[object.ParentID]
+
+		if !ok {
+			r.err = fmt.Errorf("bulk object `%s` references unknown parent `%s`", object.ID, object.ParentID)
+			return false
+		}
+
+		parent.Children = append(parent.Children, object)
+
+		if object.ID != "" {
+			r.index[object.ID] = object
+		}
+	}
+
+	r.current = r.pending
+	r.pending = nil
+
+	return r.current != nil
+}
+
+// Object returns the current top-level object.
+func (r *BulkObjectReader) Object() *BulkObject {
+	return r.current
+}
+
+// Err returns the first error that was encountered by the reader.
+func (r *BulkObjectReader) Err() error {
+	return r.err
+}
+
+// All returns an iterator over the top-level objects.
+//
+// If an error occurs, it is yielded with a nil object and the iteration
+// stops. The caller must still close the reader.
+func (r *BulkObjectReader) All() iter.Seq2[*BulkObject, error] {
+	return func(yield func(*BulkObject, error) bool) {
+		for r.Next() {
+			if !yield(r.Object(), nil) {
+				return
+			}
+		}
+
+		if err := r.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// Close closes the underlying results stream.
+func (r *BulkObjectReader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,194 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testBulkResults = `{"id":"gid://shopify/Product/1","title":"A"}
+{"id":"gid://shopify/ProductVariant/11","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/ProductVariant/12","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/InventoryLevel/111","__parentId":"gid://shopify/ProductVariant/11"}
+{"id":"gid://shopify/Product/2","title":"B"}
+
+{"id":"gid://shopify/Product/3","title":"C"}
+{"id":"gid://shopify/ProductVariant/31","__parentId":"gid://shopify/Product/3"}
+`
+
+func TestBulkObjectReader(t *testing.T) {
+	reader := NewBulkObjectReader(ioutil.NopCloser(strings.NewReader(testBulkResults)))
+	defer reader.Close()
+
+	var objects []*BulkObject
+
+	for reader.Next() {
+		objects = append(objects, reader.Object())
+	}
+
+	if err := reader.Err(); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(objects) != 3 {
+		t.Fatalf("expected %d objects but got %d", 3, len(objects))
+	}
+
+	if len(objects[0].Children) != 2 {
+		t.Fatalf("expected %d children but got %d", 2, len(objects[0].Children))
+	}
+
+	if len(objects[0].Children[0].Children) != 1 {
+		t.Errorf("expected %d children but got %d", 1, len(objects[0].Children[0].Children))
+	}
+
+	if len(objects[1].Children) != 0 {
+		t.Errorf("expected %d children but got %d", 0, len(objects[1].Children))
+	}
+
+	if len(objects[2].Children) != 1 {
+		t.Errorf("expected %d children but got %d", 1, len(objects[2].Children))
+	}
+
+	product := struct {
+		Title string `json:"title"`
+	}{}
+
+	if err := objects[2].Decode(&product); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if product.Title != "C" {
+		t.Errorf("expected `%s` but got `%s`", "C", product.Title)
+	}
+}
+
+func TestBulkObjectReaderUnknownParent(t *testing.T) {
+	reader := NewBulkObjectReader(ioutil.NopCloser(strings.NewReader(`{"id":"a","__parentId":"b"}`)))
+	defer reader.Close()
+
+	if reader.Next() {
+		t.Errorf("expected false")
+	}
+
+	if reader.Err() == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestBulkObjectReaderAll(t *testing.T) {
+	reader := NewBulkObjectReader(ioutil.NopCloser(strings.NewReader(testBulkResults + `{"id":"a","__parentId":"b"}`)))
+	defer reader.Close()
+
+	var ids []string
+	var errs []error
+
+	for object, err := range reader.All() {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		ids = append(ids, object.ID)
+	}
+
+	if expected := "[gid://shopify/Product/1 gid://shopify/Product/2]"; fmt.Sprint(ids) != expected {
+		t.Errorf("expected `%s` but got `%v`", expected, ids)
+	}
+
+	if len(errs) != 1 {
+		t.Errorf("expected 1 error but got: %v", errs)
+	}
+}
+
+func TestRunBulkQuery(t *testing.T) {
+	polls := 0
+
+	var resultsURL string
+
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/results.jsonl" {
+			if value := req.Header.Get(headerXShopifyAccessToken); value != "" {
+				t.Errorf("expected no access token but got `%s`", value)
+			}
+
+			w.Write([]byte(testBulkResults))
+			return
+		}
+
+		resultsURL = "https://" + req.Host + "/results.jsonl"
+
+		body := graphQLRequest{}
+
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("expected no error but got: %s", err)
+			return
+		}
+
+		if strings.HasPrefix(body.Query, "mutation") {
+			if body.Variables["query"] != "{ products { edges { node { id } } } }" {
+				t.Errorf("unexpected variables: %#v", body.Variables)
+			}
+
+			w.Write([]byte(`{"data":{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED","objectCount":"0"},"userErrors":[]}}}`))
+			return
+		}
+
+		polls++
+
+		if polls < 3 {
+			w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"RUNNING","objectCount":"3"}}}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"COMPLETED","objectCount":"7","url":"` + resultsURL + `"}}}`))
+	}))
+
+	reader, err := client.RunBulkQuery(ctx, "{ products { edges { node { id } } } }", &BulkQueryOptions{MinPollInterval: time.Millisecond})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	defer reader.Close()
+
+	count := 0
+
+	for _, err := range reader.All() {
+		if err != nil {
+			t.Fatalf("expected no error but got: %s", err)
+		}
+
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("expected %d objects but got %d", 3, count)
+	}
+
+	if polls != 3 {
+		t.Errorf("expected %d polls but got %d", 3, polls)
+	}
+}
+
+func TestRunBulkQueryFailed(t *testing.T) {
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := graphQLRequest{}
+		json.NewDecoder(req.Body).Decode(&body)
+
+		if strings.HasPrefix(body.Query, "mutation") {
+			w.Write([]byte(`{"data":{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED","objectCount":"0"},"userErrors":[]}}}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"FAILED","errorCode":"INTERNAL_SERVER_ERROR","objectCount":"0"}}}`))
+	}))
+
+	if _, err := client.RunBulkQuery(ctx, "{ products { edges { node { id } } } }", &BulkQueryOptions{MinPollInterval: time.Millisecond}); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
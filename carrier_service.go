@@ -0,0 +1,95 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CarrierServiceID is an ID of a carrier service.
+type CarrierServiceID int
+
+// CarrierService represents a carrier service, which provides real-time
+// shipping rates to Shopify.
+//
+// Shopify calls the callback URL of a carrier service to fetch shipping
+// rates: see app.NewCarrierServiceHandler.
+//
+// Empty fields are omitted when creating or updating a carrier service, so
+// that only the specified fields are changed.
+type CarrierService struct {
+	ID                 CarrierServiceID `json:"id,omitempty"`
+	Name               string           `json:"name,omitempty"`
+	CallbackURL        string           `json:"callback_url,omitempty"`
+	Format             string           `json:"format,omitempty"`
+	CarrierServiceType string           `json:"carrier_service_type,omitempty"`
+	Active             *bool            `json:"active,omitempty"`
+	ServiceDiscovery   *bool            `json:"service_discovery,omitempty"`
+	AdminGraphQLAPIID  string           `json:"admin_graphql_api_id,omitempty"`
+}
+
+type carrierServiceEnvelope struct {
+	CarrierService CarrierService `json:"carrier_service"`
+}
+
+// GetCarrierServices retrieves the list of all the carrier services.
+func (c *AdminClient) GetCarrierServices(ctx context.Context) ([]CarrierService, error) {
+	result := &struct {
+		CarrierServices []CarrierService `json:"carrier_services"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, "carrier_services.json", nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.CarrierServices, nil
+}
+
+// GetCarrierService fetches a carrier service by ID.
+//
+// If no such carrier service exists, a nil carrier service and no error is
+// returned.
+func (c *AdminClient) GetCarrierService(ctx context.Context, id CarrierServiceID) (*CarrierService, error) {
+	result := &carrierServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("carrier_services/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.CarrierService, nil
+}
+
+// CreateCarrierService creates a carrier service.
+func (c *AdminClient) CreateCarrierService(ctx context.Context, carrierService CarrierService) (*CarrierService, error) {
+	body := &carrierServiceEnvelope{CarrierService: carrierService}
+	result := &carrierServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "carrier_services.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CarrierService, nil
+}
+
+// UpdateCarrierService updates a carrier service.
+func (c *AdminClient) UpdateCarrierService(ctx context.Context, carrierService CarrierService) (*CarrierService, error) {
+	body := &carrierServiceEnvelope{CarrierService: carrierService}
+	result := &carrierServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("carrier_services/%d.json", carrierService.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CarrierService, nil
+}
+
+// DeleteCarrierService deletes a carrier service.
+func (c *AdminClient) DeleteCarrierService(ctx context.Context, id CarrierServiceID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("carrier_services/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
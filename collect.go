@@ -0,0 +1,144 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CollectID is an ID of a collect.
+type CollectID int
+
+// Collect represents the association of a product to a custom collection.
+type Collect struct {
+	ID           CollectID    `json:"id,omitempty"`
+	CollectionID CollectionID `json:"collection_id,omitempty"`
+	ProductID    ProductID    `json:"product_id,omitempty"`
+	Position     int          `json:"position,omitempty"`
+	SortValue    string       `json:"sort_value,omitempty"`
+	CreatedAt    *time.Time   `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"`
+}
+
+type collectEnvelope struct {
+	Collect Collect `json:"collect"`
+}
+
+// CollectFilter represents filters for listing or counting collects.
+type CollectFilter struct {
+	CollectionID CollectionID
+	ProductID    ProductID
+}
+
+func (f *CollectFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.CollectionID != 0 {
+		values.Set("collection_id", strconv.Itoa(int(f.CollectionID)))
+	}
+
+	if f.ProductID != 0 {
+		values.Set("product_id", strconv.Itoa(int(f.ProductID)))
+	}
+}
+
+// GetAllCollects retrieves a list of all collects matching the filter.
+func (c *AdminClient) GetAllCollects(ctx context.Context, filter *CollectFilter, fields SelectedFields) ([]Collect, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Collect
+
+	for page := 1; pagination != nil; page++ {
+		collects, links, err := c.GetCollects(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, collects...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCollects retrieves a list of collects matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCollects.
+func (c *AdminClient) GetCollects(ctx context.Context, filter *CollectFilter, pagination *Pagination, fields SelectedFields) ([]Collect, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Collects []Collect `json:"collects"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "collects.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Collects, parsePageLinks(header), nil
+}
+
+// GetCollectsCount retrieves the count of all collects matching the filter.
+func (c *AdminClient) GetCollectsCount(ctx context.Context, filter *CollectFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, "collects/count.json", values)
+}
+
+// GetCollect fetches a collect by ID.
+//
+// If no such collect exists, a nil collect and no error is returned.
+func (c *AdminClient) GetCollect(ctx context.Context, id CollectID, fields SelectedFields) (*Collect, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &collectEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("collects/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Collect, nil
+}
+
+// CreateCollect adds a product to a custom collection.
+func (c *AdminClient) CreateCollect(ctx context.Context, collect Collect) (*Collect, error) {
+	body := &collectEnvelope{Collect: collect}
+	result := &collectEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "collects.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Collect, nil
+}
+
+// DeleteCollect removes a product from a custom collection.
+func (c *AdminClient) DeleteCollect(ctx context.Context, id CollectID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("collects/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,145 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CollectionID is an ID of a collection, either custom or smart.
+type CollectionID int
+
+// CollectionImage represents the image of a collection.
+type CollectionImage struct {
+	Src        string     `json:"src,omitempty"`
+	Alt        string     `json:"alt,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	Height     int        `json:"height,omitempty"`
+	Attachment string     `json:"attachment,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// CollectionSortOrder represents the order in which the products of a
+// collection are sorted.
+type CollectionSortOrder string
+
+const (
+	// CollectionSortOrderAlphaAsc sorts products alphabetically, in ascending
+	// order.
+	CollectionSortOrderAlphaAsc CollectionSortOrder = "alpha-asc"
+	// CollectionSortOrderAlphaDesc sorts products alphabetically, in
+	// descending order.
+	CollectionSortOrderAlphaDesc CollectionSortOrder = "alpha-desc"
+	// CollectionSortOrderBestSelling sorts products by sales volume.
+	CollectionSortOrderBestSelling CollectionSortOrder = "best-selling"
+	// CollectionSortOrderCreated sorts products by creation date, in
+	// ascending order.
+	CollectionSortOrderCreated CollectionSortOrder = "created"
+	// CollectionSortOrderCreatedDesc sorts products by creation date, in
+	// descending order.
+	CollectionSortOrderCreatedDesc CollectionSortOrder = "created-desc"
+	// CollectionSortOrderManual sorts products in the order defined by the
+	// shop owner.
+	CollectionSortOrderManual CollectionSortOrder = "manual"
+	// CollectionSortOrderPriceAsc sorts products by price, in ascending order.
+	CollectionSortOrderPriceAsc CollectionSortOrder = "price-asc"
+	// CollectionSortOrderPriceDesc sorts products by price, in descending
+	// order.
+	CollectionSortOrderPriceDesc CollectionSortOrder = "price-desc"
+)
+
+// CollectionFilter represents filters for listing or counting custom or
+// smart collections.
+type CollectionFilter struct {
+	// IDs restricts the results to the specified collections.
+	//
+	// It is ignored when counting.
+	IDs             []CollectionID
+	Title           string
+	Handle          string
+	ProductID       ProductID
+	PublishedStatus string
+	UpdatedAtMin    time.Time
+	UpdatedAtMax    time.Time
+	PublishedAtMin  time.Time
+	PublishedAtMax  time.Time
+}
+
+func (f *CollectionFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+
+		for i, id := range f.IDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("ids", strings.Join(ids, ","))
+	}
+
+	setString(values, "title", f.Title)
+	setString(values, "handle", f.Handle)
+	setString(values, "published_status", f.PublishedStatus)
+
+	if f.ProductID != 0 {
+		values.Set("product_id", strconv.Itoa(int(f.ProductID)))
+	}
+
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "published_at_min", f.PublishedAtMin)
+	setTime(values, "published_at_max", f.PublishedAtMax)
+}
+
+// GetAllCollectionProducts retrieves a list of all the products of a
+// collection, either custom or smart.
+func (c *AdminClient) GetAllCollectionProducts(ctx context.Context, id CollectionID, fields SelectedFields) ([]Product, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Product
+
+	for page := 1; pagination != nil; page++ {
+		products, links, err := c.GetCollectionProducts(ctx, id, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, products...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCollectionProducts retrieves a list of the products of a collection,
+// either custom or smart, in the collection sort order.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCollectionProducts.
+func (c *AdminClient) GetCollectionProducts(ctx context.Context, id CollectionID, pagination *Pagination, fields SelectedFields) ([]Product, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Products []Product `json:"products"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("collections/%d/products.json", id), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Products, parsePageLinks(header), nil
+}
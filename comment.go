@@ -0,0 +1,233 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CommentID is an ID of a comment.
+type CommentID int
+
+// CommentStatus represents the moderation status of a comment.
+type CommentStatus string
+
+const (
+	// CommentStatusPending indicates that the comment awaits moderation.
+	CommentStatusPending CommentStatus = "pending"
+	// CommentStatusPublished indicates that the comment is published.
+	CommentStatusPublished CommentStatus = "published"
+	// CommentStatusUnapproved indicates that the comment was not approved.
+	CommentStatusUnapproved CommentStatus = "unapproved"
+	// CommentStatusSpam indicates that the comment was marked as spam.
+	CommentStatusSpam CommentStatus = "spam"
+	// CommentStatusRemoved indicates that the comment was removed.
+	CommentStatusRemoved CommentStatus = "removed"
+)
+
+// Comment represents a reader comment on an article.
+//
+// Empty fields are omitted when creating or updating a comment, so that only
+// the specified fields are changed.
+type Comment struct {
+	ID          CommentID     `json:"id,omitempty"`
+	BlogID      BlogID        `json:"blog_id,omitempty"`
+	ArticleID   ArticleID     `json:"article_id,omitempty"`
+	Author      string        `json:"author,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	Body        string        `json:"body,omitempty"`
+	BodyHTML    string        `json:"body_html,omitempty"`
+	IP          string        `json:"ip,omitempty"`
+	UserAgent   string        `json:"user_agent,omitempty"`
+	Status      CommentStatus `json:"status,omitempty"`
+	PublishedAt *time.Time    `json:"published_at,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+}
+
+type commentEnvelope struct {
+	Comment Comment `json:"comment"`
+}
+
+// CommentFilter represents filters to apply when listing comments.
+type CommentFilter struct {
+	BlogID          BlogID
+	ArticleID       ArticleID
+	Status          CommentStatus
+	PublishedStatus string
+	CreatedAtMin    time.Time
+	CreatedAtMax    time.Time
+	UpdatedAtMin    time.Time
+	UpdatedAtMax    time.Time
+	PublishedAtMin  time.Time
+	PublishedAtMax  time.Time
+}
+
+func (f *CommentFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.BlogID != 0 {
+		values.Set("blog_id", strconv.Itoa(int(f.BlogID)))
+	}
+
+	if f.ArticleID != 0 {
+		values.Set("article_id", strconv.Itoa(int(f.ArticleID)))
+	}
+
+	setString(values, "status", string(f.Status))
+	setString(values, "published_status", f.PublishedStatus)
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "published_at_min", f.PublishedAtMin)
+	setTime(values, "published_at_max", f.PublishedAtMax)
+}
+
+// GetAllComments retrieves a list of all comments matching the filter.
+func (c *AdminClient) GetAllComments(ctx context.Context, filter *CommentFilter, fields SelectedFields) ([]Comment, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Comment
+
+	for page := 1; pagination != nil; page++ {
+		comments, links, err := c.GetComments(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, comments...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetComments retrieves a list of comments matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllComments.
+func (c *AdminClient) GetComments(ctx context.Context, filter *CommentFilter, pagination *Pagination, fields SelectedFields) ([]Comment, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Comments []Comment `json:"comments"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "comments.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Comments, parsePageLinks(header), nil
+}
+
+// GetCommentsCount retrieves the count of all comments matching the filter.
+func (c *AdminClient) GetCommentsCount(ctx context.Context, filter *CommentFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, "comments/count.json", values)
+}
+
+// GetComment fetches a comment by ID.
+//
+// If no such comment exists, a nil comment and no error is returned.
+func (c *AdminClient) GetComment(ctx context.Context, id CommentID, fields SelectedFields) (*Comment, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &commentEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("comments/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Comment, nil
+}
+
+// CreateComment creates a comment on an article.
+//
+// The BlogID and ArticleID of the comment must be set.
+func (c *AdminClient) CreateComment(ctx context.Context, comment Comment) (*Comment, error) {
+	body := &commentEnvelope{Comment: comment}
+	result := &commentEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "comments.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Comment, nil
+}
+
+// UpdateComment updates a comment.
+func (c *AdminClient) UpdateComment(ctx context.Context, comment Comment) (*Comment, error) {
+	body := &commentEnvelope{Comment: comment}
+	result := &commentEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("comments/%d.json", comment.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Comment, nil
+}
+
+// ApproveComment approves and publishes a comment.
+func (c *AdminClient) ApproveComment(ctx context.Context, id CommentID) (*Comment, error) {
+	return c.moderateComment(ctx, id, "approve")
+}
+
+// SpamComment marks a comment as spam.
+func (c *AdminClient) SpamComment(ctx context.Context, id CommentID) (*Comment, error) {
+	return c.moderateComment(ctx, id, "spam")
+}
+
+// NotSpamComment marks a comment as not spam, which publishes it or puts it
+// back in the moderation queue, depending on the blog settings.
+func (c *AdminClient) NotSpamComment(ctx context.Context, id CommentID) (*Comment, error) {
+	return c.moderateComment(ctx, id, "not_spam")
+}
+
+// RemoveComment removes a comment.
+func (c *AdminClient) RemoveComment(ctx context.Context, id CommentID) (*Comment, error) {
+	return c.moderateComment(ctx, id, "remove")
+}
+
+// RestoreComment restores a previously removed comment.
+func (c *AdminClient) RestoreComment(ctx context.Context, id CommentID) (*Comment, error) {
+	return c.moderateComment(ctx, id, "restore")
+}
+
+// moderateComment applies a moderation action to a comment.
+//
+// Unlike the other endpoints, the moderation endpoints return the comment
+// without an envelope.
+func (c *AdminClient) moderateComment(ctx context.Context, id CommentID, action string) (*Comment, error) {
+	result := &Comment{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("comments/%d/%s.json", id, action), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestApproveComment(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/comments/7/approve.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		if req.Method != http.MethodPost {
+			t.Errorf("expected `%s` but got `%s`", http.MethodPost, req.Method)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+
+		if expected := `{}`; string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"id":7,"status":"published"}`))
+	}))
+
+	comment, err := client.ApproveComment(ctx, 7)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if comment.Status != CommentStatusPublished {
+		t.Errorf("expected `%s` but got `%s`", CommentStatusPublished, comment.Status)
+	}
+}
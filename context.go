@@ -7,6 +7,8 @@ type contextKey int
 const (
 	contextKeyShop contextKey = iota
 	contextKeyOAuthToken
+	contextKeyAPIVersion
+	contextKeyNoRetry
 )
 
 // WithShop returns a context that references a shop.
@@ -56,3 +58,20 @@ func GetAccessToken(ctx context.Context) (AccessToken, bool) {
 
 	return "", false
 }
+
+// WithAPIVersion returns a context that references an API version.
+//
+// The API version of a context takes precedence over the API version of a
+// client.
+func WithAPIVersion(ctx context.Context, version APIVersion) context.Context {
+	return context.WithValue(ctx, contextKeyAPIVersion, version)
+}
+
+// GetAPIVersion returns the API version associated to a context.
+func GetAPIVersion(ctx context.Context) (APIVersion, bool) {
+	if v := ctx.Value(contextKeyAPIVersion); v != nil {
+		return v.(APIVersion), true
+	}
+
+	return "", false
+}
@@ -50,3 +50,26 @@ func TestWithAccessToken(t *testing.T) {
 		t.Errorf("expected `%s` but got `%s`", accessToken, value)
 	}
 }
+
+func TestWithAPIVersion(t *testing.T) {
+	ctx := context.Background()
+	version := APIVersion("2019-07")
+
+	_, ok := GetAPIVersion(ctx)
+
+	if ok {
+		t.Errorf("expected false")
+	}
+
+	ctx = WithAPIVersion(ctx, version)
+
+	value, ok := GetAPIVersion(ctx)
+
+	if !ok {
+		t.Errorf("expected true")
+	}
+
+	if value != version {
+		t.Errorf("expected `%s` but got `%s`", version, value)
+	}
+}
@@ -0,0 +1,151 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CustomCollection represents a custom collection, whose products are
+// selected manually.
+//
+// Empty fields are omitted when creating or updating a collection, so that
+// only the specified fields are changed.
+type CustomCollection struct {
+	ID                CollectionID        `json:"id,omitempty"`
+	Handle            string              `json:"handle,omitempty"`
+	Title             string              `json:"title,omitempty"`
+	BodyHTML          string              `json:"body_html,omitempty"`
+	SortOrder         CollectionSortOrder `json:"sort_order,omitempty"`
+	TemplateSuffix    string              `json:"template_suffix,omitempty"`
+	PublishedScope    string              `json:"published_scope,omitempty"`
+	Published         *bool               `json:"published,omitempty"`
+	Image             *CollectionImage    `json:"image,omitempty"`
+	AdminGraphQLAPIID string              `json:"admin_graphql_api_id,omitempty"`
+	PublishedAt       *time.Time          `json:"published_at,omitempty"`
+	UpdatedAt         *time.Time          `json:"updated_at,omitempty"`
+
+	// Collects can be specified on creation to add products to the
+	// collection.
+	Collects []Collect `json:"collects,omitempty"`
+}
+
+type customCollectionEnvelope struct {
+	CustomCollection CustomCollection `json:"custom_collection"`
+}
+
+// GetAllCustomCollections retrieves a list of all custom collections
+// matching the filter.
+func (c *AdminClient) GetAllCustomCollections(ctx context.Context, filter *CollectionFilter, fields SelectedFields) ([]CustomCollection, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []CustomCollection
+
+	for page := 1; pagination != nil; page++ {
+		collections, links, err := c.GetCustomCollections(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, collections...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCustomCollections retrieves a list of custom collections matching the
+// filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCustomCollections.
+func (c *AdminClient) GetCustomCollections(ctx context.Context, filter *CollectionFilter, pagination *Pagination, fields SelectedFields) ([]CustomCollection, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		CustomCollections []CustomCollection `json:"custom_collections"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "custom_collections.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.CustomCollections, parsePageLinks(header), nil
+}
+
+// GetCustomCollectionsCount retrieves the count of all custom collections
+// matching the filter.
+func (c *AdminClient) GetCustomCollectionsCount(ctx context.Context, filter *CollectionFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "custom_collections/count.json", values)
+}
+
+// GetCustomCollection fetches a custom collection by ID.
+//
+// If no such collection exists, a nil collection and no error is returned.
+func (c *AdminClient) GetCustomCollection(ctx context.Context, id CollectionID, fields SelectedFields) (*CustomCollection, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &customCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("custom_collections/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.CustomCollection, nil
+}
+
+// CreateCustomCollection creates a custom collection.
+func (c *AdminClient) CreateCustomCollection(ctx context.Context, collection CustomCollection) (*CustomCollection, error) {
+	body := &customCollectionEnvelope{CustomCollection: collection}
+	result := &customCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "custom_collections.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomCollection, nil
+}
+
+// UpdateCustomCollection updates a custom collection.
+//
+// Only the non-empty fields of the collection are updated.
+func (c *AdminClient) UpdateCustomCollection(ctx context.Context, collection CustomCollection) (*CustomCollection, error) {
+	body := &customCollectionEnvelope{CustomCollection: collection}
+	result := &customCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("custom_collections/%d.json", collection.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomCollection, nil
+}
+
+// DeleteCustomCollection deletes a custom collection.
+func (c *AdminClient) DeleteCustomCollection(ctx context.Context, id CollectionID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("custom_collections/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,371 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CustomerID is an ID of a customer.
+type CustomerID int
+
+// CustomerState represents the state of a customer account.
+type CustomerState string
+
+const (
+	// CustomerStateDisabled indicates that the customer has no account.
+	CustomerStateDisabled CustomerState = "disabled"
+	// CustomerStateInvited indicates that the customer was invited to create
+	// an account.
+	CustomerStateInvited CustomerState = "invited"
+	// CustomerStateEnabled indicates that the customer has an account.
+	CustomerStateEnabled CustomerState = "enabled"
+	// CustomerStateDeclined indicates that the customer declined the
+	// invitation to create an account.
+	CustomerStateDeclined CustomerState = "declined"
+)
+
+// CustomerMarketingConsent represents the consent of a customer to receive
+// marketing material.
+type CustomerMarketingConsent struct {
+	State            string     `json:"state,omitempty"`
+	OptInLevel       string     `json:"opt_in_level,omitempty"`
+	ConsentUpdatedAt *time.Time `json:"consent_updated_at,omitempty"`
+}
+
+// Customer represents a customer.
+//
+// TotalSpent is in the currency indicated by Currency.
+//
+// Empty fields are omitted when creating or updating a customer, so that
+// only the specified fields are changed.
+type Customer struct {
+	ID                    CustomerID                `json:"id,omitempty"`
+	Email                 string                    `json:"email,omitempty"`
+	Phone                 string                    `json:"phone,omitempty"`
+	FirstName             string                    `json:"first_name,omitempty"`
+	LastName              string                    `json:"last_name,omitempty"`
+	State                 CustomerState             `json:"state,omitempty"`
+	Note                  string                    `json:"note,omitempty"`
+	Tags                  *ProductTags              `json:"tags,omitempty"`
+	Currency              CurrencyCode              `json:"currency,omitempty"`
+	VerifiedEmail         *bool                     `json:"verified_email,omitempty"`
+	TaxExempt             *bool                     `json:"tax_exempt,omitempty"`
+	TaxExemptions         []string                  `json:"tax_exemptions,omitempty"`
+	MultipassIdentifier   string                    `json:"multipass_identifier,omitempty"`
+	OrdersCount           int                       `json:"orders_count,omitempty"`
+	TotalSpent            *Money                    `json:"total_spent,omitempty"`
+	LastOrderID           OrderID                   `json:"last_order_id,omitempty"`
+	LastOrderName         string                    `json:"last_order_name,omitempty"`
+	Addresses             []CustomerAddress         `json:"addresses,omitempty"`
+	DefaultAddress        *CustomerAddress          `json:"default_address,omitempty"`
+	EmailMarketingConsent *CustomerMarketingConsent `json:"email_marketing_consent,omitempty"`
+	SMSMarketingConsent   *CustomerMarketingConsent `json:"sms_marketing_consent,omitempty"`
+	AdminGraphQLAPIID     string                    `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt             *time.Time                `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time                `json:"updated_at,omitempty"`
+
+	// Password and PasswordConfirmation can be set when creating or updating
+	// a customer, to set the password of its account. They are never
+	// returned.
+	Password             string `json:"password,omitempty"`
+	PasswordConfirmation string `json:"password_confirmation,omitempty"`
+
+	// SendEmailWelcome can be set when creating a customer, to send a
+	// welcome email. It is never returned.
+	SendEmailWelcome *bool `json:"send_email_welcome,omitempty"`
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// The amounts are given the currency of the customer.
+func (c *Customer) UnmarshalJSON(b []byte) error {
+	type customer Customer
+
+	if err := json.Unmarshal(b, (*customer)(c)); err != nil {
+		return err
+	}
+
+	setMoneyCurrency(reflect.ValueOf(c), c.Currency)
+
+	return nil
+}
+
+type customerEnvelope struct {
+	Customer Customer `json:"customer"`
+}
+
+// CustomerFilter represents filters to apply when listing customers.
+type CustomerFilter struct {
+	// IDs restricts the results to the specified customers.
+	//
+	// It is ignored when counting.
+	IDs          []CustomerID
+	CreatedAtMin time.Time
+	CreatedAtMax time.Time
+	UpdatedAtMin time.Time
+	UpdatedAtMax time.Time
+}
+
+func (f *CustomerFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+
+		for i, id := range f.IDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("ids", strings.Join(ids, ","))
+	}
+
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+}
+
+// GetAllCustomers retrieves a list of all customers matching the filter.
+func (c *AdminClient) GetAllCustomers(ctx context.Context, filter *CustomerFilter, fields SelectedFields) ([]Customer, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Customer
+
+	for page := 1; pagination != nil; page++ {
+		customers, links, err := c.GetCustomers(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, customers...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCustomers retrieves a list of customers matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCustomers.
+func (c *AdminClient) GetCustomers(ctx context.Context, filter *CustomerFilter, pagination *Pagination, fields SelectedFields) ([]Customer, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Customers []Customer `json:"customers"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "customers.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Customers, parsePageLinks(header), nil
+}
+
+// SearchAllCustomers retrieves a list of all customers matching the query.
+//
+// See SearchCustomers for the query syntax.
+func (c *AdminClient) SearchAllCustomers(ctx context.Context, query string, order string, fields SelectedFields) ([]Customer, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Customer
+
+	for page := 1; pagination != nil; page++ {
+		customers, links, err := c.SearchCustomers(ctx, query, order, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, customers...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// SearchCustomers retrieves a list of customers matching the query.
+//
+// The query uses the Shopify search syntax, such as `email:bob@example.com`
+// or `country:Canada orders_count:>2`. The order, such as
+// `last_order_date desc`, may be empty.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use SearchAllCustomers.
+func (c *AdminClient) SearchCustomers(ctx context.Context, query string, order string, pagination *Pagination, fields SelectedFields) ([]Customer, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		setString(values, "query", query)
+		setString(values, "order", order)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Customers []Customer `json:"customers"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "customers/search.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Customers, parsePageLinks(header), nil
+}
+
+// GetCustomersCount retrieves the count of all customers matching the
+// filter.
+func (c *AdminClient) GetCustomersCount(ctx context.Context, filter *CustomerFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "customers/count.json", values)
+}
+
+// GetCustomer fetches a customer by ID.
+//
+// If no such customer exists, a nil customer and no error is returned.
+func (c *AdminClient) GetCustomer(ctx context.Context, id CustomerID, fields SelectedFields) (*Customer, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &customerEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customers/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Customer, nil
+}
+
+// CreateCustomer creates a customer, along with its addresses.
+func (c *AdminClient) CreateCustomer(ctx context.Context, customer Customer) (*Customer, error) {
+	body := &customerEnvelope{Customer: customer}
+	result := &customerEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "customers.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Customer, nil
+}
+
+// UpdateCustomer updates a customer.
+func (c *AdminClient) UpdateCustomer(ctx context.Context, customer Customer) (*Customer, error) {
+	body := &customerEnvelope{Customer: customer}
+	result := &customerEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("customers/%d.json", customer.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Customer, nil
+}
+
+// DeleteCustomer deletes a customer.
+//
+// Customers with existing orders cannot be deleted.
+func (c *AdminClient) DeleteCustomer(ctx context.Context, id CustomerID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("customers/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
+
+// GetCustomerOrders retrieves the list of the orders of a customer.
+//
+// If status is empty, only the open orders are returned.
+func (c *AdminClient) GetCustomerOrders(ctx context.Context, id CustomerID, status OrderStatus, fields SelectedFields) ([]Order, error) {
+	values := url.Values{}
+	setString(values, "status", string(status))
+	fields.injectInto(values)
+
+	result := &struct {
+		Orders []Order `json:"orders"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customers/%d/orders.json", id), values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Orders, nil
+}
+
+// CustomerInvite represents an account invite sent to a customer.
+//
+// Empty fields are replaced by the defaults of the shop.
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	BCC           []string `json:"bcc,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+}
+
+// SendCustomerInvite sends an account invite to a customer.
+//
+// Invite may be nil, in which case the default invite of the shop is sent.
+func (c *AdminClient) SendCustomerInvite(ctx context.Context, id CustomerID, invite *CustomerInvite) (*CustomerInvite, error) {
+	if invite == nil {
+		invite = &CustomerInvite{}
+	}
+
+	body := &struct {
+		CustomerInvite *CustomerInvite `json:"customer_invite"`
+	}{
+		CustomerInvite: invite,
+	}
+	result := &struct {
+		CustomerInvite CustomerInvite `json:"customer_invite"`
+	}{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("customers/%d/send_invite.json", id), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerInvite, nil
+}
+
+// GetCustomerAccountActivationURL generates a single-use URL that lets a
+// customer activate its account.
+func (c *AdminClient) GetCustomerAccountActivationURL(ctx context.Context, id CustomerID) (string, error) {
+	result := &struct {
+		AccountActivationURL string `json:"account_activation_url"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("customers/%d/account_activation_url.json", id), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return "", err
+	}
+
+	return result.AccountActivationURL, nil
+}
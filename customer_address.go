@@ -0,0 +1,135 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CustomerAddressID is an ID of a customer address.
+type CustomerAddressID int
+
+// CustomerAddress represents an address of a customer.
+type CustomerAddress struct {
+	ID          CustomerAddressID `json:"id,omitempty"`
+	CustomerID  CustomerID        `json:"customer_id,omitempty"`
+	Default     bool              `json:"default,omitempty"`
+	CountryName string            `json:"country_name,omitempty"`
+	Address
+}
+
+type customerAddressEnvelope struct {
+	CustomerAddress CustomerAddress `json:"customer_address"`
+}
+
+// The create and update endpoints expect an `address` key, but return a
+// `customer_address` key.
+type customerAddressBody struct {
+	Address CustomerAddress `json:"address"`
+}
+
+// GetAllCustomerAddresses retrieves a list of all the addresses of a
+// customer.
+func (c *AdminClient) GetAllCustomerAddresses(ctx context.Context, customerID CustomerID) ([]CustomerAddress, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []CustomerAddress
+
+	for page := 1; pagination != nil; page++ {
+		addresses, links, err := c.GetCustomerAddresses(ctx, customerID, pagination)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, addresses...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCustomerAddresses retrieves a list of the addresses of a customer.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCustomerAddresses.
+func (c *AdminClient) GetCustomerAddresses(ctx context.Context, customerID CustomerID, pagination *Pagination) ([]CustomerAddress, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+
+	result := &struct {
+		Addresses []CustomerAddress `json:"addresses"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customers/%d/addresses.json", customerID), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Addresses, parsePageLinks(header), nil
+}
+
+// GetCustomerAddress fetches an address of a customer by ID.
+//
+// If no such address exists, a nil address and no error is returned.
+func (c *AdminClient) GetCustomerAddress(ctx context.Context, customerID CustomerID, id CustomerAddressID) (*CustomerAddress, error) {
+	result := &customerAddressEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customers/%d/addresses/%d.json", customerID, id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.CustomerAddress, nil
+}
+
+// CreateCustomerAddress creates an address for a customer.
+func (c *AdminClient) CreateCustomerAddress(ctx context.Context, customerID CustomerID, address CustomerAddress) (*CustomerAddress, error) {
+	body := &customerAddressBody{Address: address}
+	result := &customerAddressEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("customers/%d/addresses.json", customerID), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerAddress, nil
+}
+
+// UpdateCustomerAddress updates an address of a customer.
+func (c *AdminClient) UpdateCustomerAddress(ctx context.Context, customerID CustomerID, address CustomerAddress) (*CustomerAddress, error) {
+	body := &customerAddressBody{Address: address}
+	result := &customerAddressEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("customers/%d/addresses/%d.json", customerID, address.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerAddress, nil
+}
+
+// SetDefaultCustomerAddress sets the default address of a customer.
+func (c *AdminClient) SetDefaultCustomerAddress(ctx context.Context, customerID CustomerID, id CustomerAddressID) (*CustomerAddress, error) {
+	result := &customerAddressEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("customers/%d/addresses/%d/default.json", customerID, id), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerAddress, nil
+}
+
+// DeleteCustomerAddress deletes an address of a customer.
+//
+// The default address of a customer cannot be deleted.
+func (c *AdminClient) DeleteCustomerAddress(ctx context.Context, customerID CustomerID, id CustomerAddressID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("customers/%d/addresses/%d.json", customerID, id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
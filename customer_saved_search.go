@@ -0,0 +1,150 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CustomerSavedSearchID is an ID of a customer saved search.
+type CustomerSavedSearchID int
+
+// CustomerSavedSearch represents a named customer search query.
+//
+// The query uses the same syntax as SearchCustomers.
+type CustomerSavedSearch struct {
+	ID        CustomerSavedSearchID `json:"id,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Query     string                `json:"query,omitempty"`
+	CreatedAt *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt *time.Time            `json:"updated_at,omitempty"`
+}
+
+type customerSavedSearchEnvelope struct {
+	CustomerSavedSearch CustomerSavedSearch `json:"customer_saved_search"`
+}
+
+// GetAllCustomerSavedSearches retrieves a list of all the customer saved
+// searches.
+func (c *AdminClient) GetAllCustomerSavedSearches(ctx context.Context, fields SelectedFields) ([]CustomerSavedSearch, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []CustomerSavedSearch
+
+	for page := 1; pagination != nil; page++ {
+		savedSearches, links, err := c.GetCustomerSavedSearches(ctx, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, savedSearches...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetCustomerSavedSearches retrieves a list of customer saved searches.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllCustomerSavedSearches.
+func (c *AdminClient) GetCustomerSavedSearches(ctx context.Context, pagination *Pagination, fields SelectedFields) ([]CustomerSavedSearch, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		CustomerSavedSearches []CustomerSavedSearch `json:"customer_saved_searches"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "customer_saved_searches.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.CustomerSavedSearches, parsePageLinks(header), nil
+}
+
+// GetCustomerSavedSearchesCount retrieves the count of all the customer saved
+// searches.
+func (c *AdminClient) GetCustomerSavedSearchesCount(ctx context.Context) (int, error) {
+	return c.getCount(ctx, "customer_saved_searches/count.json", nil)
+}
+
+// GetCustomerSavedSearch fetches a customer saved search by ID.
+//
+// If no such saved search exists, a nil saved search and no error is
+// returned.
+func (c *AdminClient) GetCustomerSavedSearch(ctx context.Context, id CustomerSavedSearchID, fields SelectedFields) (*CustomerSavedSearch, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &customerSavedSearchEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customer_saved_searches/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.CustomerSavedSearch, nil
+}
+
+// GetCustomerSavedSearchCustomers retrieves the list of the customers
+// matching a customer saved search.
+//
+// The order, such as `last_order_date desc`, may be empty.
+func (c *AdminClient) GetCustomerSavedSearchCustomers(ctx context.Context, id CustomerSavedSearchID, order string, fields SelectedFields) ([]Customer, error) {
+	values := url.Values{}
+	setString(values, "order", order)
+	fields.injectInto(values)
+
+	result := &struct {
+		Customers []Customer `json:"customers"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("customer_saved_searches/%d/customers.json", id), values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Customers, nil
+}
+
+// CreateCustomerSavedSearch creates a customer saved search.
+func (c *AdminClient) CreateCustomerSavedSearch(ctx context.Context, savedSearch CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	body := &customerSavedSearchEnvelope{CustomerSavedSearch: savedSearch}
+	result := &customerSavedSearchEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "customer_saved_searches.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerSavedSearch, nil
+}
+
+// UpdateCustomerSavedSearch updates a customer saved search.
+func (c *AdminClient) UpdateCustomerSavedSearch(ctx context.Context, savedSearch CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	body := &customerSavedSearchEnvelope{CustomerSavedSearch: savedSearch}
+	result := &customerSavedSearchEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("customer_saved_searches/%d.json", savedSearch.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.CustomerSavedSearch, nil
+}
+
+// DeleteCustomerSavedSearch deletes a customer saved search.
+func (c *AdminClient) DeleteCustomerSavedSearch(ctx context.Context, id CustomerSavedSearchID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("customer_saved_searches/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,72 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSearchCustomers(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/customers/search.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		if req.URL.Query().Get("page_info") == "" {
+			if expected := "email:bob@example.com"; req.URL.Query().Get("query") != expected {
+				t.Errorf("expected `%s` but got `%s`", expected, req.URL.Query().Get("query"))
+			}
+
+			w.Header().Set("Link", `<https://shop.myshopify.com/admin/api/2024-07/customers/search.json?limit=250&page_info=abc>; rel="next"`)
+			w.Write([]byte(`{"customers":[{"id":1,"email":"bob@example.com","state":"enabled","total_spent":"199.65"}]}`))
+
+			return
+		}
+
+		if req.URL.Query().Get("query") != "" {
+			t.Errorf("expected no query with a cursor but got `%s`", req.URL.Query().Get("query"))
+		}
+
+		w.Write([]byte(`{"customers":[{"id":2,"email":"bob@example.com","state":"disabled"}]}`))
+	}))
+
+	customers, err := client.SearchAllCustomers(ctx, "email:bob@example.com", "", nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(customers) != 2 {
+		t.Fatalf("expected 2 customers but got %d", len(customers))
+	}
+
+	if customers[0].State != CustomerStateEnabled || customers[0].TotalSpent.String() != "199.65" {
+		t.Errorf("unexpected customer: %#v", customers[0])
+	}
+}
+
+func TestCreateCustomerAddress(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"address":{"city":"Ottawa","country_code":"CA"}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"customer_address":{"id":2,"customer_id":1,"default":false,"city":"Ottawa","country_code":"CA","country_name":"Canada"}}`))
+	}))
+
+	address, err := client.CreateCustomerAddress(ctx, 1, CustomerAddress{
+		Address: Address{City: "Ottawa", CountryCode: "CA"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if address.ID != 2 || address.CountryName != "Canada" || address.City != "Ottawa" {
+		t.Errorf("unexpected address: %#v", address)
+	}
+}
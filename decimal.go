@@ -0,0 +1,304 @@
+package shopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal represents an exact decimal number, such as a monetary amount.
+//
+// Shopify represents amounts as decimal strings, such as "19.99". Unlike
+// float64, a Decimal represents them exactly, so that amounts are never
+// rounded.
+//
+// The zero value is 0. Decimal values are immutable.
+type Decimal struct {
+	// The value is unscaled * 10^-scale.
+	unscaled *big.Int
+	scale    int
+}
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalScale bounds the exponents accepted by ParseDecimal, as large
+// exponents are expensive to compute and never used for amounts.
+const maxDecimalScale = 300
+
+// NewDecimal returns the decimal unscaled * 10^-scale. For instance,
+// NewDecimal(1999, 2) is 19.99.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{
+			unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale)),
+		}
+	}
+
+	return Decimal{
+		unscaled: big.NewInt(unscaled),
+		scale:    scale,
+	}
+}
+
+// ParseDecimal parses a decimal string, such as "19.99", "-3", ".5" or
+// "1e-2".
+func ParseDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	exponent := 0
+
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.Atoi(str[i+1:])
+
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal `%s`", s)
+		}
+
+		exponent = e
+		str = str[:i]
+	}
+
+	sign := ""
+
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		sign, str = str[:1], str[1:]
+	}
+
+	integer, fraction := str, ""
+
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		integer, fraction = str[:i], str[i+1:]
+	}
+
+	digits := integer + fraction
+
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return Decimal{}, fmt.Errorf("invalid decimal `%s`", s)
+	}
+
+	unscaled, ok := new(big.Int).SetString(sign+digits, 10)
+
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal `%s`", s)
+	}
+
+	scale := len(fraction) - exponent
+
+	if exponent > maxDecimalScale || exponent < -maxDecimalScale || scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("decimal `%s` is out of range", s)
+	}
+
+	if scale < 0 {
+		return Decimal{unscaled: unscaled.Mul(unscaled, pow10(-scale))}, nil
+	}
+
+	return Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if the string is invalid.
+//
+// It is meant to be used with constant strings.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d at the specified scale, which must
+// not be lower than the scale of d.
+func (d Decimal) rescale(scale int) *big.Int {
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+// align returns the unscaled values of d and other at a common scale.
+func (d Decimal) align(other Decimal) (*big.Int, *big.Int, int) {
+	scale := d.scale
+
+	if other.scale > scale {
+		scale = other.scale
+	}
+
+	return d.rescale(scale), other.rescale(scale), scale
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Add returns d + other.
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := d.align(other)
+
+	return Decimal{unscaled: a.Add(a, b), scale: scale}
+}
+
+// Sub returns d - other.
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := d.align(other)
+
+	return Decimal{unscaled: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d * other.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{
+		unscaled: new(big.Int).Mul(d.int(), other.int()),
+		scale:    d.scale + other.scale,
+	}
+}
+
+// MulInt returns d * n.
+func (d Decimal) MulInt(n int64) Decimal {
+	return Decimal{
+		unscaled: new(big.Int).Mul(d.int(), big.NewInt(n)),
+		scale:    d.scale,
+	}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{
+		unscaled: new(big.Int).Neg(d.int()),
+		scale:    d.scale,
+	}
+}
+
+// Round returns d rounded to the specified number of digits after the
+// decimal point, rounding halves away from zero.
+//
+// A negative scale rounds to a power of ten: Round(-1) rounds 15 to 20.
+func (d Decimal) Round(scale int) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+
+	divisor := pow10(d.scale - scale)
+	quotient, remainder := new(big.Int).QuoRem(d.int(), divisor, new(big.Int))
+
+	// Compare twice the remainder to the divisor to detect halves.
+	remainder.Abs(remainder).Lsh(remainder, 1)
+
+	if remainder.Cmp(divisor) >= 0 {
+		if d.int().Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	if scale < 0 {
+		return Decimal{unscaled: quotient.Mul(quotient, pow10(-scale))}
+	}
+
+	return Decimal{unscaled: quotient, scale: scale}
+}
+
+// Cmp compares d and other and returns -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := d.align(other)
+
+	return a.Cmp(b)
+}
+
+// Equal returns true if d and other represent the same number, regardless
+// of their scale: 1.5 equals 1.50.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns true if d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Float64 returns the nearest float64 value of d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+
+	return f
+}
+
+// String returns the decimal representation of d, with as many digits after
+// the decimal point as its scale.
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	sign := ""
+
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.scale == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+
+	i := len(digits) - d.scale
+
+	return sign + digits[:i] + "." + digits[i:]
+}
+
+// MarshalJSON implements JSON marshalling.
+//
+// Decimals are marshalled as strings, as Shopify does.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// Both string and number representations are supported. A null value is
+// unmarshalled as 0.
+func (d *Decimal) UnmarshalJSON(b []byte) (err error) {
+	b = bytes.TrimSpace(b)
+
+	if string(b) == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	s := string(b)
+
+	if len(b) > 0 && b[0] == '"' {
+		if err = json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+
+		// Shopify sometimes uses empty strings for missing amounts.
+		if strings.TrimSpace(s) == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+
+	*d, err = ParseDecimal(s)
+
+	return
+}
@@ -0,0 +1,155 @@
+package shopify
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	testCases := []struct {
+		S        string
+		Expected string
+		Valid    bool
+	}{
+		{"19.99", "19.99", true},
+		{"-3", "-3", true},
+		{"+3.0", "3.0", true},
+		{".5", "0.5", true},
+		{"5.", "5", true},
+		{"0.001", "0.001", true},
+		{"1e-2", "0.01", true},
+		{"1.5E3", "1500", true},
+		{"123456789012345678901234567890.01", "123456789012345678901234567890.01", true},
+		{"", "", false},
+		{".", "", false},
+		{"1.2.3", "", false},
+		{"abc", "", false},
+		{"1e", "", false},
+		{"--1", "", false},
+		{"1e300", "1" + strings.Repeat("0", 300), true},
+		{"1e20000000", "", false},
+		{"1e-20000000", "", false},
+		{"0." + strings.Repeat("0", 400) + "1", "", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.S, func(t *testing.T) {
+			value, err := ParseDecimal(testCase.S)
+
+			if !testCase.Valid {
+				if err == nil {
+					t.Errorf("expected an error but got: %s", value)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %s", err)
+			}
+
+			if value.String() != testCase.Expected {
+				t.Errorf("expected `%s` but got `%s`", testCase.Expected, value.String())
+			}
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+
+	if value := a.Add(b); value.String() != "0.3" {
+		t.Errorf("expected `%s` but got `%s`", "0.3", value)
+	}
+
+	if value := a.Sub(MustParseDecimal("1.25")); value.String() != "-1.15" {
+		t.Errorf("expected `%s` but got `%s`", "-1.15", value)
+	}
+
+	if value := MustParseDecimal("19.99").MulInt(3); value.String() != "59.97" {
+		t.Errorf("expected `%s` but got `%s`", "59.97", value)
+	}
+
+	if value := MustParseDecimal("19.99").Mul(MustParseDecimal("0.2")); value.String() != "3.998" {
+		t.Errorf("expected `%s` but got `%s`", "3.998", value)
+	}
+
+	if value := MustParseDecimal("3.995").Round(2); value.String() != "4.00" {
+		t.Errorf("expected `%s` but got `%s`", "4.00", value)
+	}
+
+	if value := MustParseDecimal("-3.995").Round(2); value.String() != "-4.00" {
+		t.Errorf("expected `%s` but got `%s`", "-4.00", value)
+	}
+
+	if value := MustParseDecimal("3.994").Round(2); value.String() != "3.99" {
+		t.Errorf("expected `%s` but got `%s`", "3.99", value)
+	}
+
+	if value := MustParseDecimal("15").Round(-1); value.String() != "20" {
+		t.Errorf("expected `%s` but got `%s`", "20", value)
+	}
+
+	if value := MustParseDecimal("-1234.5").Round(-2); value.String() != "-1200" {
+		t.Errorf("expected `%s` but got `%s`", "-1200", value)
+	}
+
+	if !MustParseDecimal("1.5").Equal(MustParseDecimal("1.50")) {
+		t.Errorf("expected true")
+	}
+
+	if MustParseDecimal("1.5").Cmp(MustParseDecimal("1.49")) != 1 {
+		t.Errorf("expected 1")
+	}
+
+	var zero Decimal
+
+	if !zero.IsZero() || zero.String() != "0" || zero.Add(a).String() != "0.1" {
+		t.Errorf("unexpected zero value behavior")
+	}
+
+	if value := NewDecimal(5, 3); value.String() != "0.005" {
+		t.Errorf("expected `%s` but got `%s`", "0.005", value)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	value := struct {
+		A Decimal  `json:"a"`
+		B Decimal  `json:"b"`
+		C Decimal  `json:"c"`
+		D *Decimal `json:"d,omitempty"`
+	}{}
+
+	data := []byte(`{"a": "19.99", "b": 0.1, "c": null}`)
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if value.A.String() != "19.99" || value.B.String() != "0.1" || !value.C.IsZero() {
+		t.Errorf("unexpected values: %s, %s, %s", value.A, value.B, value.C)
+	}
+
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	expected := `{"a":"19.99","b":"0.1","c":"0"}`
+
+	if string(data) != expected {
+		t.Errorf("expected: %s\ngot: %s", expected, string(data))
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": "nope"}`), &value); err == nil {
+		t.Errorf("expected an error")
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": 1e20000000}`), &value); err == nil {
+		t.Errorf("expected an error")
+	}
+}
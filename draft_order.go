@@ -0,0 +1,349 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DraftOrderID is an ID of a draft order.
+type DraftOrderID int
+
+// DraftOrderStatus represents the status of a draft order.
+type DraftOrderStatus string
+
+const (
+	// DraftOrderStatusOpen indicates that the draft order is open.
+	DraftOrderStatusOpen DraftOrderStatus = "open"
+	// DraftOrderStatusInvoiceSent indicates that an invoice was sent for the
+	// draft order.
+	DraftOrderStatusInvoiceSent DraftOrderStatus = "invoice_sent"
+	// DraftOrderStatusCompleted indicates that the draft order was turned
+	// into an order.
+	DraftOrderStatusCompleted DraftOrderStatus = "completed"
+)
+
+// AppliedDiscountValueType represents how the value of an applied discount
+// is interpreted.
+type AppliedDiscountValueType string
+
+const (
+	// AppliedDiscountValueTypeFixedAmount indicates that the value is an
+	// amount of money.
+	AppliedDiscountValueTypeFixedAmount AppliedDiscountValueType = "fixed_amount"
+	// AppliedDiscountValueTypePercentage indicates that the value is a
+	// percentage.
+	AppliedDiscountValueTypePercentage AppliedDiscountValueType = "percentage"
+)
+
+// AppliedDiscount represents a custom discount applied to a draft order or
+// to one of its line items.
+type AppliedDiscount struct {
+	Title       string                   `json:"title,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Value       *Decimal                 `json:"value,omitempty"`
+	ValueType   AppliedDiscountValueType `json:"value_type,omitempty"`
+
+	// Amount is the resulting discount amount. It is computed by Shopify.
+	Amount *Money `json:"amount,omitempty"`
+}
+
+// DraftOrderLineItemID is an ID of a draft order line item.
+type DraftOrderLineItemID int
+
+// DraftOrderLineItem represents a line item of a draft order.
+//
+// A line item is either a product variant, in which case VariantID must be
+// set, or a custom line item, in which case Title and Price must be set.
+type DraftOrderLineItem struct {
+	ID               DraftOrderLineItemID `json:"id,omitempty"`
+	VariantID        ProductVariantID     `json:"variant_id,omitempty"`
+	ProductID        ProductID            `json:"product_id,omitempty"`
+	Title            string               `json:"title,omitempty"`
+	VariantTitle     string               `json:"variant_title,omitempty"`
+	SKU              string               `json:"sku,omitempty"`
+	Vendor           string               `json:"vendor,omitempty"`
+	Quantity         int                  `json:"quantity,omitempty"`
+	Price            *Money               `json:"price,omitempty"`
+	Grams            int                  `json:"grams,omitempty"`
+	Taxable          *bool                `json:"taxable,omitempty"`
+	RequiresShipping *bool                `json:"requires_shipping,omitempty"`
+	Custom           bool                 `json:"custom,omitempty"`
+	AppliedDiscount  *AppliedDiscount     `json:"applied_discount,omitempty"`
+	Properties       []LineItemProperty   `json:"properties,omitempty"`
+	TaxLines         []TaxLine            `json:"tax_lines,omitempty"`
+}
+
+// DraftOrderShippingLine represents the shipping method of a draft order.
+type DraftOrderShippingLine struct {
+	Title  string `json:"title,omitempty"`
+	Handle string `json:"handle,omitempty"`
+	Price  *Money `json:"price,omitempty"`
+	Custom bool   `json:"custom,omitempty"`
+}
+
+// DraftOrder represents a draft order, which merchants create on behalf of
+// customers and which turns into an order once completed.
+//
+// The amounts of the draft order and of its lines are in the currency
+// indicated by Currency.
+//
+// Empty fields are omitted when creating or updating a draft order, so that
+// only the specified fields are changed.
+type DraftOrder struct {
+	ID                        DraftOrderID            `json:"id,omitempty"`
+	OrderID                   OrderID                 `json:"order_id,omitempty"`
+	Name                      string                  `json:"name,omitempty"`
+	Email                     string                  `json:"email,omitempty"`
+	Note                      string                  `json:"note,omitempty"`
+	NoteAttributes            []NoteAttribute         `json:"note_attributes,omitempty"`
+	Tags                      *ProductTags            `json:"tags,omitempty"`
+	Status                    DraftOrderStatus        `json:"status,omitempty"`
+	Currency                  CurrencyCode            `json:"currency,omitempty"`
+	TaxExempt                 *bool                   `json:"tax_exempt,omitempty"`
+	TaxesIncluded             bool                    `json:"taxes_included,omitempty"`
+	LineItems                 []DraftOrderLineItem    `json:"line_items,omitempty"`
+	ShippingLine              *DraftOrderShippingLine `json:"shipping_line,omitempty"`
+	AppliedDiscount           *AppliedDiscount        `json:"applied_discount,omitempty"`
+	TaxLines                  []TaxLine               `json:"tax_lines,omitempty"`
+	Customer                  *Customer               `json:"customer,omitempty"`
+	UseCustomerDefaultAddress *bool                   `json:"use_customer_default_address,omitempty"`
+	BillingAddress            *Address                `json:"billing_address,omitempty"`
+	ShippingAddress           *Address                `json:"shipping_address,omitempty"`
+	SubtotalPrice             *Money                  `json:"subtotal_price,omitempty"`
+	TotalTax                  *Money                  `json:"total_tax,omitempty"`
+	TotalPrice                *Money                  `json:"total_price,omitempty"`
+	InvoiceURL                string                  `json:"invoice_url,omitempty"`
+	AdminGraphQLAPIID         string                  `json:"admin_graphql_api_id,omitempty"`
+	InvoiceSentAt             *time.Time              `json:"invoice_sent_at,omitempty"`
+	CompletedAt               *time.Time              `json:"completed_at,omitempty"`
+	CreatedAt                 *time.Time              `json:"created_at,omitempty"`
+	UpdatedAt                 *time.Time              `json:"updated_at,omitempty"`
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// The amounts are given the currency of the draft order.
+func (o *DraftOrder) UnmarshalJSON(b []byte) error {
+	type draftOrder DraftOrder
+
+	if err := json.Unmarshal(b, (*draftOrder)(o)); err != nil {
+		return err
+	}
+
+	setMoneyCurrency(reflect.ValueOf(o), o.Currency)
+
+	return nil
+}
+
+type draftOrderEnvelope struct {
+	DraftOrder DraftOrder `json:"draft_order"`
+}
+
+// DraftOrderFilter represents filters to apply when listing draft orders.
+type DraftOrderFilter struct {
+	// IDs restricts the results to the specified draft orders.
+	//
+	// It is ignored when counting.
+	IDs          []DraftOrderID
+	Status       DraftOrderStatus
+	UpdatedAtMin time.Time
+	UpdatedAtMax time.Time
+}
+
+func (f *DraftOrderFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+
+		for i, id := range f.IDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("ids", strings.Join(ids, ","))
+	}
+
+	setString(values, "status", string(f.Status))
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+}
+
+// GetAllDraftOrders retrieves a list of all draft orders matching the filter.
+func (c *AdminClient) GetAllDraftOrders(ctx context.Context, filter *DraftOrderFilter, fields SelectedFields) ([]DraftOrder, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []DraftOrder
+
+	for page := 1; pagination != nil; page++ {
+		draftOrders, links, err := c.GetDraftOrders(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, draftOrders...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetDraftOrders retrieves a list of draft orders matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllDraftOrders.
+func (c *AdminClient) GetDraftOrders(ctx context.Context, filter *DraftOrderFilter, pagination *Pagination, fields SelectedFields) ([]DraftOrder, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		DraftOrders []DraftOrder `json:"draft_orders"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "draft_orders.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.DraftOrders, parsePageLinks(header), nil
+}
+
+// GetDraftOrdersCount retrieves the count of all draft orders matching the
+// filter.
+func (c *AdminClient) GetDraftOrdersCount(ctx context.Context, filter *DraftOrderFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "draft_orders/count.json", values)
+}
+
+// GetDraftOrder fetches a draft order by ID.
+//
+// If no such draft order exists, a nil draft order and no error is returned.
+func (c *AdminClient) GetDraftOrder(ctx context.Context, id DraftOrderID, fields SelectedFields) (*DraftOrder, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &draftOrderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("draft_orders/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.DraftOrder, nil
+}
+
+// CreateDraftOrder creates a draft order.
+func (c *AdminClient) CreateDraftOrder(ctx context.Context, draftOrder DraftOrder) (*DraftOrder, error) {
+	body := &draftOrderEnvelope{DraftOrder: draftOrder}
+	result := &draftOrderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "draft_orders.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.DraftOrder, nil
+}
+
+// UpdateDraftOrder updates a draft order.
+//
+// When specified, the line items replace the existing ones.
+func (c *AdminClient) UpdateDraftOrder(ctx context.Context, draftOrder DraftOrder) (*DraftOrder, error) {
+	body := &draftOrderEnvelope{DraftOrder: draftOrder}
+	result := &draftOrderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("draft_orders/%d.json", draftOrder.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.DraftOrder, nil
+}
+
+// DeleteDraftOrder deletes a draft order.
+func (c *AdminClient) DeleteDraftOrder(ctx context.Context, id DraftOrderID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("draft_orders/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
+
+// DraftOrderInvoice represents an invoice sent for a draft order.
+//
+// Empty fields are replaced by the defaults of the shop.
+type DraftOrderInvoice struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	BCC           []string `json:"bcc,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+}
+
+// SendDraftOrderInvoice sends an invoice for a draft order.
+//
+// Invoice may be nil, in which case the default invoice of the shop is sent
+// to the customer of the draft order.
+func (c *AdminClient) SendDraftOrderInvoice(ctx context.Context, id DraftOrderID, invoice *DraftOrderInvoice) (*DraftOrderInvoice, error) {
+	if invoice == nil {
+		invoice = &DraftOrderInvoice{}
+	}
+
+	body := &struct {
+		DraftOrderInvoice *DraftOrderInvoice `json:"draft_order_invoice"`
+	}{
+		DraftOrderInvoice: invoice,
+	}
+	result := &struct {
+		DraftOrderInvoice DraftOrderInvoice `json:"draft_order_invoice"`
+	}{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("draft_orders/%d/send_invoice.json", id), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.DraftOrderInvoice, nil
+}
+
+// CompleteDraftOrder turns a draft order into an order.
+//
+// If paymentPending is true, the order is created with a pending payment.
+// Otherwise, it is marked as paid.
+//
+// As completing a draft order is not idempotent, the request is never
+// retried.
+func (c *AdminClient) CompleteDraftOrder(ctx context.Context, id DraftOrderID, paymentPending bool) (*DraftOrder, error) {
+	values := url.Values{}
+
+	if paymentPending {
+		values.Set("payment_pending", "true")
+	}
+
+	result := &draftOrderEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPut, fmt.Sprintf("draft_orders/%d/complete.json", id), values, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.DraftOrder, nil
+}
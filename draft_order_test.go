@@ -0,0 +1,70 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestCreateDraftOrder(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"draft_order":{"line_items":[{"title":"Custom Tee","quantity":2,"price":"20.00"}],"applied_discount":{"title":"Wholesale","value":"10.0","value_type":"percentage"}}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"draft_order":{"id":1,"status":"open","line_items":[{"title":"Custom Tee","quantity":2,"price":"20.00","custom":true}],"applied_discount":{"title":"Wholesale","value":"10.0","value_type":"percentage","amount":"4.00"},"currency":"USD","subtotal_price":"36.00"}}`))
+	}))
+
+	price := NewMoney(MustParseDecimal("20.00"), "USD")
+	value := MustParseDecimal("10.0")
+	draftOrder, err := client.CreateDraftOrder(ctx, DraftOrder{
+		LineItems: []DraftOrderLineItem{
+			{Title: "Custom Tee", Quantity: 2, Price: &price},
+		},
+		AppliedDiscount: &AppliedDiscount{
+			Title:     "Wholesale",
+			Value:     &value,
+			ValueType: AppliedDiscountValueTypePercentage,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if draftOrder.Status != DraftOrderStatusOpen || !draftOrder.LineItems[0].Custom {
+		t.Errorf("unexpected draft order: %#v", draftOrder)
+	}
+
+	if expected := "36.00 USD"; draftOrder.SubtotalPrice.String() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, draftOrder.SubtotalPrice)
+	}
+}
+
+func TestCompleteDraftOrder(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("expected `%s` but got `%s`", http.MethodPut, req.Method)
+		}
+
+		if expected := "payment_pending=true"; req.URL.RawQuery != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"draft_order":{"id":1,"status":"completed","order_id":2}}`))
+	}))
+
+	draftOrder, err := client.CompleteDraftOrder(ctx, 1, true)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if draftOrder.OrderID != 2 {
+		t.Errorf("expected `2` but got `%d`", draftOrder.OrderID)
+	}
+}
@@ -0,0 +1,223 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// FulfillmentID is an ID of a fulfillment.
+type FulfillmentID int
+
+// FulfillmentStatus represents the status of a fulfillment.
+type FulfillmentStatus string
+
+const (
+	// FulfillmentStatusPending indicates that the fulfillment is pending.
+	FulfillmentStatusPending FulfillmentStatus = "pending"
+	// FulfillmentStatusOpen indicates that the fulfillment is awaiting
+	// processing by the fulfillment service.
+	FulfillmentStatusOpen FulfillmentStatus = "open"
+	// FulfillmentStatusSuccess indicates that the fulfillment succeeded.
+	FulfillmentStatusSuccess FulfillmentStatus = "success"
+	// FulfillmentStatusCancelled indicates that the fulfillment was
+	// cancelled.
+	FulfillmentStatusCancelled FulfillmentStatus = "cancelled"
+	// FulfillmentStatusError indicates that an error occurred.
+	FulfillmentStatusError FulfillmentStatus = "error"
+	// FulfillmentStatusFailure indicates that the fulfillment failed.
+	FulfillmentStatusFailure FulfillmentStatus = "failure"
+)
+
+// TrackingInfo represents the tracking information of a fulfillment.
+type TrackingInfo struct {
+	Number  string `json:"number,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Company string `json:"company,omitempty"`
+}
+
+// Fulfillment represents a shipment of some line items of an order.
+type Fulfillment struct {
+	ID              FulfillmentID     `json:"id,omitempty"`
+	OrderID         OrderID           `json:"order_id,omitempty"`
+	LocationID      LocationID        `json:"location_id,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Status          FulfillmentStatus `json:"status,omitempty"`
+	ShipmentStatus  string            `json:"shipment_status,omitempty"`
+	Service         string            `json:"service,omitempty"`
+	TrackingCompany string            `json:"tracking_company,omitempty"`
+	TrackingNumber  string            `json:"tracking_number,omitempty"`
+	TrackingNumbers []string          `json:"tracking_numbers,omitempty"`
+	TrackingURL     string            `json:"tracking_url,omitempty"`
+	TrackingURLs    []string          `json:"tracking_urls,omitempty"`
+	LineItems       []LineItem        `json:"line_items,omitempty"`
+	CreatedAt       *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time        `json:"updated_at,omitempty"`
+}
+
+type fulfillmentEnvelope struct {
+	Fulfillment Fulfillment `json:"fulfillment"`
+}
+
+// FulfillmentOrderLineItems represents the line items of a fulfillment
+// order to fulfill.
+type FulfillmentOrderLineItems struct {
+	// FulfillmentOrderID is the ID of the fulfillment order.
+	FulfillmentOrderID FulfillmentOrderID `json:"fulfillment_order_id"`
+
+	// FulfillmentOrderLineItems are the line items to fulfill. If empty, all
+	// the line items of the fulfillment order are fulfilled.
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentOptions represents the options of a fulfillment creation.
+type FulfillmentOptions struct {
+	// TrackingInfo is the tracking information of the shipment.
+	TrackingInfo *TrackingInfo `json:"tracking_info,omitempty"`
+
+	// NotifyCustomer indicates whether to send a shipping confirmation to the
+	// customer.
+	NotifyCustomer bool `json:"notify_customer,omitempty"`
+
+	// Message is an optional message about the fulfillment.
+	Message string `json:"message,omitempty"`
+}
+
+// GetAllFulfillments retrieves a list of all the fulfillments of an order.
+func (c *AdminClient) GetAllFulfillments(ctx context.Context, orderID OrderID, fields SelectedFields) ([]Fulfillment, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Fulfillment
+
+	for page := 1; pagination != nil; page++ {
+		fulfillments, links, err := c.GetFulfillments(ctx, orderID, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, fulfillments...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetFulfillments retrieves a list of the fulfillments of an order.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllFulfillments.
+func (c *AdminClient) GetFulfillments(ctx context.Context, orderID OrderID, pagination *Pagination, fields SelectedFields) ([]Fulfillment, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Fulfillments []Fulfillment `json:"fulfillments"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/fulfillments.json", orderID), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Fulfillments, parsePageLinks(header), nil
+}
+
+// GetFulfillmentOrderFulfillments retrieves the list of all the fulfillments
+// of a fulfillment order.
+func (c *AdminClient) GetFulfillmentOrderFulfillments(ctx context.Context, id FulfillmentOrderID) ([]Fulfillment, error) {
+	result := &struct {
+		Fulfillments []Fulfillment `json:"fulfillments"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("fulfillment_orders/%d/fulfillments.json", id), nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Fulfillments, nil
+}
+
+// GetFulfillment fetches a fulfillment of an order by ID.
+//
+// If no such fulfillment exists, a nil fulfillment and no error is returned.
+func (c *AdminClient) GetFulfillment(ctx context.Context, orderID OrderID, id FulfillmentID, fields SelectedFields) (*Fulfillment, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &fulfillmentEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/fulfillments/%d.json", orderID, id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Fulfillment, nil
+}
+
+// CreateFulfillment fulfills some line items of one or more fulfillment
+// orders, which must all belong to the same order and location.
+//
+// Options may be nil.
+func (c *AdminClient) CreateFulfillment(ctx context.Context, lineItems []FulfillmentOrderLineItems, options *FulfillmentOptions) (*Fulfillment, error) {
+	if options == nil {
+		options = &FulfillmentOptions{}
+	}
+
+	body := &struct {
+		Fulfillment struct {
+			LineItemsByFulfillmentOrder []FulfillmentOrderLineItems `json:"line_items_by_fulfillment_order"`
+			*FulfillmentOptions
+		} `json:"fulfillment"`
+	}{}
+	body.Fulfillment.LineItemsByFulfillmentOrder = lineItems
+	body.Fulfillment.FulfillmentOptions = options
+
+	result := &fulfillmentEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, "fulfillments.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Fulfillment, nil
+}
+
+// UpdateFulfillmentTracking updates the tracking information of a
+// fulfillment.
+func (c *AdminClient) UpdateFulfillmentTracking(ctx context.Context, id FulfillmentID, trackingInfo TrackingInfo, notifyCustomer bool) (*Fulfillment, error) {
+	body := &struct {
+		Fulfillment struct {
+			TrackingInfo   TrackingInfo `json:"tracking_info"`
+			NotifyCustomer bool         `json:"notify_customer"`
+		} `json:"fulfillment"`
+	}{}
+	body.Fulfillment.TrackingInfo = trackingInfo
+	body.Fulfillment.NotifyCustomer = notifyCustomer
+
+	result := &fulfillmentEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("fulfillments/%d/update_tracking.json", id), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Fulfillment, nil
+}
+
+// CancelFulfillment cancels a fulfillment.
+func (c *AdminClient) CancelFulfillment(ctx context.Context, id FulfillmentID) (*Fulfillment, error) {
+	result := &fulfillmentEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("fulfillments/%d/cancel.json", id), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Fulfillment, nil
+}
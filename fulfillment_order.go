@@ -0,0 +1,293 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// FulfillmentOrderID is an ID of a fulfillment order.
+type FulfillmentOrderID int
+
+// FulfillmentOrderStatus represents the status of a fulfillment order.
+type FulfillmentOrderStatus string
+
+const (
+	// FulfillmentOrderStatusOpen indicates that the fulfillment order is
+	// ready for fulfillment.
+	FulfillmentOrderStatusOpen FulfillmentOrderStatus = "open"
+	// FulfillmentOrderStatusInProgress indicates that the fulfillment order
+	// is being processed.
+	FulfillmentOrderStatusInProgress FulfillmentOrderStatus = "in_progress"
+	// FulfillmentOrderStatusScheduled indicates that the fulfillment order is
+	// deferred to a later date.
+	FulfillmentOrderStatusScheduled FulfillmentOrderStatus = "scheduled"
+	// FulfillmentOrderStatusOnHold indicates that the fulfillment order is on
+	// hold.
+	FulfillmentOrderStatusOnHold FulfillmentOrderStatus = "on_hold"
+	// FulfillmentOrderStatusIncomplete indicates that the fulfillment order
+	// cannot be completed as requested.
+	FulfillmentOrderStatusIncomplete FulfillmentOrderStatus = "incomplete"
+	// FulfillmentOrderStatusCancelled indicates that the fulfillment order
+	// was cancelled.
+	FulfillmentOrderStatusCancelled FulfillmentOrderStatus = "cancelled"
+	// FulfillmentOrderStatusClosed indicates that the fulfillment order was
+	// completed.
+	FulfillmentOrderStatusClosed FulfillmentOrderStatus = "closed"
+)
+
+// FulfillmentOrderRequestStatus represents the status of the requests sent
+// to the fulfillment service of a fulfillment order.
+type FulfillmentOrderRequestStatus string
+
+const (
+	// FulfillmentOrderRequestStatusUnsubmitted indicates that no request was
+	// sent.
+	FulfillmentOrderRequestStatusUnsubmitted FulfillmentOrderRequestStatus = "unsubmitted"
+	// FulfillmentOrderRequestStatusSubmitted indicates that a fulfillment
+	// request was sent.
+	FulfillmentOrderRequestStatusSubmitted FulfillmentOrderRequestStatus = "submitted"
+	// FulfillmentOrderRequestStatusAccepted indicates that the fulfillment
+	// request was accepted.
+	FulfillmentOrderRequestStatusAccepted FulfillmentOrderRequestStatus = "accepted"
+	// FulfillmentOrderRequestStatusRejected indicates that the fulfillment
+	// request was rejected.
+	FulfillmentOrderRequestStatusRejected FulfillmentOrderRequestStatus = "rejected"
+	// FulfillmentOrderRequestStatusCancellationRequested indicates that a
+	// cancellation request was sent.
+	FulfillmentOrderRequestStatusCancellationRequested FulfillmentOrderRequestStatus = "cancellation_requested"
+	// FulfillmentOrderRequestStatusCancellationAccepted indicates that the
+	// cancellation request was accepted.
+	FulfillmentOrderRequestStatusCancellationAccepted FulfillmentOrderRequestStatus = "cancellation_accepted"
+	// FulfillmentOrderRequestStatusCancellationRejected indicates that the
+	// cancellation request was rejected.
+	FulfillmentOrderRequestStatusCancellationRejected FulfillmentOrderRequestStatus = "cancellation_rejected"
+	// FulfillmentOrderRequestStatusClosed indicates that the fulfillment
+	// service closed the fulfillment order without completing it.
+	FulfillmentOrderRequestStatusClosed FulfillmentOrderRequestStatus = "closed"
+)
+
+// FulfillmentHoldReason represents the reason of a fulfillment hold.
+type FulfillmentHoldReason string
+
+const (
+	// FulfillmentHoldReasonAwaitingPayment indicates that the payment is
+	// pending.
+	FulfillmentHoldReasonAwaitingPayment FulfillmentHoldReason = "awaiting_payment"
+	// FulfillmentHoldReasonHighRiskOfFraud indicates that the order is
+	// likely fraudulent.
+	FulfillmentHoldReasonHighRiskOfFraud FulfillmentHoldReason = "high_risk_of_fraud"
+	// FulfillmentHoldReasonIncorrectAddress indicates that the shipping
+	// address is incorrect.
+	FulfillmentHoldReasonIncorrectAddress FulfillmentHoldReason = "incorrect_address"
+	// FulfillmentHoldReasonInventoryOutOfStock indicates that some items are
+	// out of stock.
+	FulfillmentHoldReasonInventoryOutOfStock FulfillmentHoldReason = "inventory_out_of_stock"
+	// FulfillmentHoldReasonOther indicates another reason.
+	FulfillmentHoldReasonOther FulfillmentHoldReason = "other"
+)
+
+// FulfillmentHold represents a hold on a fulfillment order.
+type FulfillmentHold struct {
+	Reason      FulfillmentHoldReason `json:"reason,omitempty"`
+	ReasonNotes string                `json:"reason_notes,omitempty"`
+}
+
+// FulfillmentOrderLineItemID is an ID of a fulfillment order line item.
+type FulfillmentOrderLineItemID int
+
+// FulfillmentOrderLineItem represents a line item of a fulfillment order.
+type FulfillmentOrderLineItem struct {
+	ID                  FulfillmentOrderLineItemID `json:"id,omitempty"`
+	FulfillmentOrderID  FulfillmentOrderID         `json:"fulfillment_order_id,omitempty"`
+	LineItemID          LineItemID                 `json:"line_item_id,omitempty"`
+	VariantID           ProductVariantID           `json:"variant_id,omitempty"`
+	InventoryItemID     InventoryItemID            `json:"inventory_item_id,omitempty"`
+	Quantity            int                        `json:"quantity,omitempty"`
+	FulfillableQuantity int                        `json:"fulfillable_quantity,omitempty"`
+}
+
+// FulfillmentOrderLineItemQuantity represents a quantity of a fulfillment
+// order line item, to move, hold or fulfill part of a fulfillment order.
+type FulfillmentOrderLineItemQuantity struct {
+	ID       FulfillmentOrderLineItemID `json:"id"`
+	Quantity int                        `json:"quantity"`
+}
+
+// FulfillmentOrderDestination represents the destination of a fulfillment
+// order.
+type FulfillmentOrderDestination struct {
+	ID    int    `json:"id,omitempty"`
+	Email string `json:"email,omitempty"`
+	Address
+}
+
+// FulfillmentOrderAssignedLocation represents the location a fulfillment
+// order is assigned to.
+type FulfillmentOrderAssignedLocation struct {
+	LocationID LocationID `json:"location_id,omitempty"`
+	Address
+}
+
+// FulfillmentOrder represents a group of line items of an order that are
+// fulfilled from the same location.
+type FulfillmentOrder struct {
+	ID                 FulfillmentOrderID                `json:"id,omitempty"`
+	OrderID            OrderID                           `json:"order_id,omitempty"`
+	AssignedLocationID LocationID                        `json:"assigned_location_id,omitempty"`
+	AssignedLocation   *FulfillmentOrderAssignedLocation `json:"assigned_location,omitempty"`
+	Destination        *FulfillmentOrderDestination      `json:"destination,omitempty"`
+	Status             FulfillmentOrderStatus            `json:"status,omitempty"`
+	RequestStatus      FulfillmentOrderRequestStatus     `json:"request_status,omitempty"`
+	SupportedActions   []string                          `json:"supported_actions,omitempty"`
+	LineItems          []FulfillmentOrderLineItem        `json:"line_items,omitempty"`
+	FulfillmentHolds   []FulfillmentHold                 `json:"fulfillment_holds,omitempty"`
+	FulfillAt          *time.Time                        `json:"fulfill_at,omitempty"`
+	FulfillBy          *time.Time                        `json:"fulfill_by,omitempty"`
+	CreatedAt          *time.Time                        `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time                        `json:"updated_at,omitempty"`
+}
+
+type fulfillmentOrderEnvelope struct {
+	FulfillmentOrder FulfillmentOrder `json:"fulfillment_order"`
+}
+
+// GetFulfillmentOrders retrieves the list of all the fulfillment orders of an
+// order.
+func (c *AdminClient) GetFulfillmentOrders(ctx context.Context, orderID OrderID) ([]FulfillmentOrder, error) {
+	result := &struct {
+		FulfillmentOrders []FulfillmentOrder `json:"fulfillment_orders"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/fulfillment_orders.json", orderID), nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.FulfillmentOrders, nil
+}
+
+// GetFulfillmentOrder fetches a fulfillment order by ID.
+//
+// If no such fulfillment order exists, a nil fulfillment order and no error
+// is returned.
+func (c *AdminClient) GetFulfillmentOrder(ctx context.Context, id FulfillmentOrderID) (*FulfillmentOrder, error) {
+	result := &fulfillmentOrderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("fulfillment_orders/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.FulfillmentOrder, nil
+}
+
+// FulfillmentOrderMove represents the result of a fulfillment order move.
+type FulfillmentOrderMove struct {
+	// OriginalFulfillmentOrder is the fulfillment order that was moved.
+	OriginalFulfillmentOrder *FulfillmentOrder `json:"original_fulfillment_order"`
+
+	// MovedFulfillmentOrder is the fulfillment order assigned to the new
+	// location.
+	MovedFulfillmentOrder *FulfillmentOrder `json:"moved_fulfillment_order"`
+
+	// RemainingFulfillmentOrder holds the line items that were not moved, if
+	// any.
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remaining_fulfillment_order"`
+}
+
+// MoveFulfillmentOrder moves a fulfillment order to another location.
+//
+// If lineItems is empty, all the line items are moved.
+func (c *AdminClient) MoveFulfillmentOrder(ctx context.Context, id FulfillmentOrderID, locationID LocationID, lineItems []FulfillmentOrderLineItemQuantity) (*FulfillmentOrderMove, error) {
+	body := &struct {
+		FulfillmentOrder struct {
+			NewLocationID             LocationID                         `json:"new_location_id"`
+			FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+		} `json:"fulfillment_order"`
+	}{}
+	body.FulfillmentOrder.NewLocationID = locationID
+	body.FulfillmentOrder.FulfillmentOrderLineItems = lineItems
+
+	result := &FulfillmentOrderMove{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("fulfillment_orders/%d/move.json", id), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FulfillmentHoldOptions represents the options of a fulfillment order hold.
+type FulfillmentHoldOptions struct {
+	// Reason is the reason of the hold.
+	Reason FulfillmentHoldReason `json:"reason"`
+
+	// ReasonNotes are additional notes about the hold.
+	ReasonNotes string `json:"reason_notes,omitempty"`
+
+	// NotifyMerchant indicates whether to notify the merchant.
+	NotifyMerchant bool `json:"notify_merchant,omitempty"`
+
+	// FulfillmentOrderLineItems are the line items to hold. If empty, all
+	// the line items are held.
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// HoldFulfillmentOrder puts a fulfillment order on hold.
+func (c *AdminClient) HoldFulfillmentOrder(ctx context.Context, id FulfillmentOrderID, options FulfillmentHoldOptions) (*FulfillmentOrder, error) {
+	body := &struct {
+		FulfillmentHold FulfillmentHoldOptions `json:"fulfillment_hold"`
+	}{
+		FulfillmentHold: options,
+	}
+
+	return c.postFulfillmentOrderAction(ctx, id, "hold", body)
+}
+
+// ReleaseFulfillmentOrderHold releases the holds of a fulfillment order.
+func (c *AdminClient) ReleaseFulfillmentOrderHold(ctx context.Context, id FulfillmentOrderID) (*FulfillmentOrder, error) {
+	return c.postFulfillmentOrderAction(ctx, id, "release_hold", struct{}{})
+}
+
+// CancelFulfillmentOrder cancels a fulfillment order.
+func (c *AdminClient) CancelFulfillmentOrder(ctx context.Context, id FulfillmentOrderID) (*FulfillmentOrder, error) {
+	return c.postFulfillmentOrderAction(ctx, id, "cancel", struct{}{})
+}
+
+func (c *AdminClient) postFulfillmentOrderAction(ctx context.Context, id FulfillmentOrderID, action string, body interface{}) (*FulfillmentOrder, error) {
+	result := &fulfillmentOrderEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("fulfillment_orders/%d/%s.json", id, action), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.FulfillmentOrder, nil
+}
+
+type fulfillmentRequestMessage struct {
+	FulfillmentRequest struct {
+		Message string `json:"message,omitempty"`
+	} `json:"fulfillment_request"`
+}
+
+// AcceptFulfillmentRequest accepts the fulfillment request sent to the
+// calling fulfillment service for a fulfillment order.
+func (c *AdminClient) AcceptFulfillmentRequest(ctx context.Context, id FulfillmentOrderID, message string) (*FulfillmentOrder, error) {
+	body := &fulfillmentRequestMessage{}
+	body.FulfillmentRequest.Message = message
+
+	return c.postFulfillmentOrderAction(ctx, id, "fulfillment_request/accept", body)
+}
+
+// RejectFulfillmentRequest rejects the fulfillment request sent to the
+// calling fulfillment service for a fulfillment order.
+func (c *AdminClient) RejectFulfillmentRequest(ctx context.Context, id FulfillmentOrderID, message string) (*FulfillmentOrder, error) {
+	body := &fulfillmentRequestMessage{}
+	body.FulfillmentRequest.Message = message
+
+	return c.postFulfillmentOrderAction(ctx, id, "fulfillment_request/reject", body)
+}
@@ -0,0 +1,164 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// FulfillmentServiceID is an ID of a fulfillment service.
+type FulfillmentServiceID int
+
+// FulfillmentService represents a third-party fulfillment service.
+//
+// Shopify calls the callback URL of a fulfillment service to fetch stock
+// levels and tracking numbers, and to notify it of fulfillment requests: see
+// app.NewFulfillmentServiceHandler.
+//
+// Empty fields are omitted when creating or updating a fulfillment service,
+// so that only the specified fields are changed.
+type FulfillmentService struct {
+	ID                     FulfillmentServiceID `json:"id,omitempty"`
+	Name                   string               `json:"name,omitempty"`
+	Handle                 string               `json:"handle,omitempty"`
+	Email                  string               `json:"email,omitempty"`
+	CallbackURL            string               `json:"callback_url,omitempty"`
+	Format                 string               `json:"format,omitempty"`
+	LocationID             LocationID           `json:"location_id,omitempty"`
+	ProviderID             string               `json:"provider_id,omitempty"`
+	InventoryManagement    *bool                `json:"inventory_management,omitempty"`
+	TrackingSupport        *bool                `json:"tracking_support,omitempty"`
+	RequiresShippingMethod *bool                `json:"requires_shipping_method,omitempty"`
+	FulfillmentOrdersOptIn *bool                `json:"fulfillment_orders_opt_in,omitempty"`
+	PermitsSKUSharing      *bool                `json:"permits_sku_sharing,omitempty"`
+	AdminGraphQLAPIID      string               `json:"admin_graphql_api_id,omitempty"`
+}
+
+type fulfillmentServiceEnvelope struct {
+	FulfillmentService FulfillmentService `json:"fulfillment_service"`
+}
+
+// GetFulfillmentServices retrieves the list of the fulfillment services.
+//
+// If all is false, only the fulfillment services of the calling app are
+// returned.
+func (c *AdminClient) GetFulfillmentServices(ctx context.Context, all bool) ([]FulfillmentService, error) {
+	values := url.Values{}
+
+	if all {
+		values.Set("scope", "all")
+	} else {
+		values.Set("scope", "current_client")
+	}
+
+	result := &struct {
+		FulfillmentServices []FulfillmentService `json:"fulfillment_services"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, "fulfillment_services.json", values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.FulfillmentServices, nil
+}
+
+// GetFulfillmentService fetches a fulfillment service by ID.
+//
+// If no such fulfillment service exists, a nil fulfillment service and no
+// error is returned.
+func (c *AdminClient) GetFulfillmentService(ctx context.Context, id FulfillmentServiceID) (*FulfillmentService, error) {
+	result := &fulfillmentServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("fulfillment_services/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.FulfillmentService, nil
+}
+
+// CreateFulfillmentService creates a fulfillment service.
+//
+// Shopify creates a location for the fulfillment service, whose ID is
+// returned in LocationID.
+func (c *AdminClient) CreateFulfillmentService(ctx context.Context, fulfillmentService FulfillmentService) (*FulfillmentService, error) {
+	body := &fulfillmentServiceEnvelope{FulfillmentService: fulfillmentService}
+	result := &fulfillmentServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "fulfillment_services.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.FulfillmentService, nil
+}
+
+// UpdateFulfillmentService updates a fulfillment service.
+func (c *AdminClient) UpdateFulfillmentService(ctx context.Context, fulfillmentService FulfillmentService) (*FulfillmentService, error) {
+	body := &fulfillmentServiceEnvelope{FulfillmentService: fulfillmentService}
+	result := &fulfillmentServiceEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("fulfillment_services/%d.json", fulfillmentService.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.FulfillmentService, nil
+}
+
+// DeleteFulfillmentService deletes a fulfillment service.
+func (c *AdminClient) DeleteFulfillmentService(ctx context.Context, id FulfillmentServiceID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("fulfillment_services/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
+
+// FulfillmentOrderAssignmentStatus represents the status of the requests
+// made to a fulfillment service for a fulfillment order.
+type FulfillmentOrderAssignmentStatus string
+
+const (
+	// FulfillmentOrderAssignmentStatusFulfillmentRequested selects the
+	// fulfillment orders for which fulfillment was requested.
+	FulfillmentOrderAssignmentStatusFulfillmentRequested FulfillmentOrderAssignmentStatus = "fulfillment_requested"
+	// FulfillmentOrderAssignmentStatusFulfillmentAccepted selects the
+	// fulfillment orders whose fulfillment request was accepted.
+	FulfillmentOrderAssignmentStatusFulfillmentAccepted FulfillmentOrderAssignmentStatus = "fulfillment_accepted"
+	// FulfillmentOrderAssignmentStatusCancellationRequested selects the
+	// fulfillment orders for which cancellation was requested.
+	FulfillmentOrderAssignmentStatusCancellationRequested FulfillmentOrderAssignmentStatus = "cancellation_requested"
+)
+
+// GetAssignedFulfillmentOrders retrieves the list of the fulfillment orders
+// assigned to the locations of the calling fulfillment service.
+//
+// If locationIDs is empty, the fulfillment orders of all its locations are
+// returned.
+func (c *AdminClient) GetAssignedFulfillmentOrders(ctx context.Context, status FulfillmentOrderAssignmentStatus, locationIDs []LocationID) ([]FulfillmentOrder, error) {
+	values := url.Values{}
+	setString(values, "assignment_status", string(status))
+
+	if len(locationIDs) > 0 {
+		ids := make([]string, len(locationIDs))
+
+		for i, id := range locationIDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("location_ids", strings.Join(ids, ","))
+	}
+
+	result := &struct {
+		FulfillmentOrders []FulfillmentOrder `json:"fulfillment_orders"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, "assigned_fulfillment_orders.json", values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.FulfillmentOrders, nil
+}
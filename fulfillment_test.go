@@ -0,0 +1,72 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestCreateFulfillment(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/fulfillments.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"fulfillment":{"line_items_by_fulfillment_order":[{"fulfillment_order_id":1,"fulfillment_order_line_items":[{"id":2,"quantity":1}]}],"tracking_info":{"number":"1Z001985YW99744790","company":"UPS"},"notify_customer":true}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"fulfillment":{"id":3,"order_id":4,"status":"success","tracking_number":"1Z001985YW99744790"}}`))
+	}))
+
+	fulfillment, err := client.CreateFulfillment(ctx, []FulfillmentOrderLineItems{
+		{
+			FulfillmentOrderID: 1,
+			FulfillmentOrderLineItems: []FulfillmentOrderLineItemQuantity{
+				{ID: 2, Quantity: 1},
+			},
+		},
+	}, &FulfillmentOptions{
+		TrackingInfo:   &TrackingInfo{Number: "1Z001985YW99744790", Company: "UPS"},
+		NotifyCustomer: true,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if fulfillment.Status != FulfillmentStatusSuccess {
+		t.Errorf("expected `%s` but got `%s`", FulfillmentStatusSuccess, fulfillment.Status)
+	}
+}
+
+func TestMoveFulfillmentOrder(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"fulfillment_order":{"new_location_id":5}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"original_fulfillment_order":{"id":1,"status":"closed"},"moved_fulfillment_order":{"id":2,"status":"open","assigned_location_id":5},"remaining_fulfillment_order":null}`))
+	}))
+
+	move, err := client.MoveFulfillmentOrder(ctx, 1, 5, nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if move.MovedFulfillmentOrder == nil || move.MovedFulfillmentOrder.AssignedLocationID != 5 {
+		t.Errorf("unexpected moved fulfillment order: %#v", move.MovedFulfillmentOrder)
+	}
+
+	if move.RemainingFulfillmentOrder != nil {
+		t.Errorf("expected no remaining fulfillment order but got: %#v", move.RemainingFulfillmentOrder)
+	}
+}
@@ -1,5 +1,7 @@
 module github.com/go-shopify/shopify
 
+go 1.23
+
 require (
 	github.com/gorilla/context v1.1.1 // indirect
 	github.com/gorilla/mux v1.6.2
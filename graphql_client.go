@@ -0,0 +1,293 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// DefaultGraphQLClient is the default GraphQL client.
+var DefaultGraphQLClient = &GraphQLClient{}
+
+// GraphQLClient represents a Shopify client, that can interact with the
+// Shopify GraphQL Admin API.
+//
+// Like an AdminClient, a GraphQLClient takes its credentials from the
+// specified context. See WithShop, WithAccessToken and WithAPIVersion.
+//
+// Queries are throttled according to their cost, to stay within the Shopify
+// GraphQL Admin API rate limits. Throttled queries are retried up to the
+// maximum number of attempts of the retry policy. The throttling state of a
+// shop is shared by all GraphQLClient instances.
+type GraphQLClient struct {
+	// HTTPClient is the HTTP client to use for requests.
+	//
+	// If none is specified, DefaultHTTPClient is used.
+	HTTPClient *http.Client
+
+	// RetryPolicy is the policy to use for retrying failed requests.
+	//
+	// As GraphQL requests are POST requests, they are only retried if the
+	// policy explicitly lists the POST method as retryable.
+	//
+	// If none is specified, DefaultRetryPolicy is used.
+	RetryPolicy *RetryPolicy
+
+	// APIVersion is the API version to use for requests.
+	//
+	// If the context specifies an API version (see WithAPIVersion), it takes
+	// precedence. If none is specified, DefaultAPIVersion is used.
+	APIVersion APIVersion
+
+	// Logger is the logger to report API deprecations to.
+	//
+	// If none is specified, the standard logger of the log package is used.
+	Logger *log.Logger
+}
+
+// adminClient returns an AdminClient with the same settings, whose helpers
+// are shared by both clients.
+func (c *GraphQLClient) adminClient() *AdminClient {
+	return &AdminClient{
+		HTTPClient:  c.HTTPClient,
+		RetryPolicy: c.RetryPolicy,
+		APIVersion:  c.APIVersion,
+		Logger:      c.Logger,
+	}
+}
+
+// GraphQLErrorLocation represents the location of a GraphQL error in a
+// query.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLError represents a top-level error returned by the GraphQL API.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Code returns the error code, as found in the error extensions.
+func (e GraphQLError) Code() string {
+	code, _ := e.Extensions["code"].(string)
+
+	return code
+}
+
+func (e GraphQLError) Error() string {
+	if code := e.Code(); code != "" {
+		return fmt.Sprintf("%s (%s)", e.Message, code)
+	}
+
+	return e.Message
+}
+
+// GraphQLErrors represents the list of top-level errors returned by the
+// GraphQL API.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, len(e))
+
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return "shopify: GraphQL errors: " + strings.Join(messages, ", ")
+}
+
+// HasCode returns true if any of the errors has the specified code.
+func (e GraphQLErrors) HasCode(code string) bool {
+	for _, err := range e {
+		if err.Code() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UserError represents an error caused by the input of a mutation, as
+// returned in the `userErrors` field of mutation payloads.
+type UserError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+	Code    string   `json:"code,omitempty"`
+}
+
+func (e UserError) Error() string {
+	if len(e.Field) > 0 {
+		return fmt.Sprintf("%s: %s", strings.Join(e.Field, "."), e.Message)
+	}
+
+	return e.Message
+}
+
+// UserErrors represents a list of user errors.
+type UserErrors []UserError
+
+func (e UserErrors) Error() string {
+	messages := make([]string, len(e))
+
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return "shopify: user errors: " + strings.Join(messages, ", ")
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data       json.RawMessage   `json:"data"`
+	Errors     GraphQLErrors     `json:"errors"`
+	Extensions graphQLExtensions `json:"extensions"`
+}
+
+// findUserErrors looks for user errors in the root fields of the data, which
+// is where mutation payloads are.
+func findUserErrors(data json.RawMessage) UserErrors {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	var result UserErrors
+
+	for _, field := range fields {
+		payload := struct {
+			UserErrors UserErrors `json:"userErrors"`
+		}{}
+
+		if err := json.Unmarshal(field, &payload); err == nil {
+			result = append(result, payload.UserErrors...)
+		}
+	}
+
+	return result
+}
+
+// Do sends a GraphQL query (or mutation) with the specified variables, and
+// decodes the returned `data` into result, unless result is nil.
+//
+// If the response contains top-level errors, a GraphQLErrors is returned,
+// after any partial data was decoded. If a mutation payload contains user
+// errors, a UserErrors is returned after the data was decoded.
+func (c *GraphQLClient) Do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	resp, err := c.do(ctx, query, variables)
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if result != nil {
+			if err := json.Unmarshal(resp.Data, result); err != nil {
+				return fmt.Errorf("unable to parse response data: %s", err)
+			}
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+
+	if userErrors := findUserErrors(resp.Data); len(userErrors) > 0 {
+		return userErrors
+	}
+
+	return nil
+}
+
+// do sends a GraphQL query, waiting for the shop cost bucket to have enough
+// points available and retrying the query when it gets throttled.
+func (c *GraphQLClient) do(ctx context.Context, query string, variables map[string]interface{}) (*graphQLResponse, error) {
+	shop, ok := GetShop(ctx)
+
+	if !ok {
+		return nil, errors.New("context contains no shop")
+	}
+
+	bucket := getCostBucket(shop)
+	maxAttempts := c.adminClient().retryPolicy().MaxAttempts
+
+	for attempt := 1; ; attempt++ {
+		reserved, err := bucket.reserve(ctx, query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.post(ctx, query, variables)
+
+		if err != nil {
+			bucket.release(reserved)
+			return nil, err
+		}
+
+		bucket.update(query, reserved, resp.Extensions.Cost)
+
+		if !resp.Errors.HasCode(graphQLThrottledCode) || attempt >= maxAttempts {
+			return resp, nil
+		}
+	}
+}
+
+func (c *GraphQLClient) post(ctx context.Context, query string, variables map[string]interface{}) (*graphQLResponse, error) {
+	rest := c.adminClient()
+
+	req, err := rest.newRequest(ctx, http.MethodPost, "graphql.json", nil, graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+
+	resp, err := rest.retryPolicy().do(req, c.send)
+
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	defer flushAndCloseBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := &graphQLResponse{}
+
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("unable to parse response: %s", err)
+	}
+
+	return result, nil
+}
+
+// send sends a request to the Shopify API.
+func (c *GraphQLClient) send(req *http.Request) (*http.Response, error) {
+	rest := c.adminClient()
+	resp, err := rest.httpClient().Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rest.checkAPIVersion(req, resp)
+
+	return resp, nil
+}
@@ -0,0 +1,118 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestGraphQLClient instantiates a GraphQLClient that talks to a local
+// test server, along with a context referencing it as the shop.
+func newTestGraphQLClient(t *testing.T, handler http.Handler) (context.Context, *GraphQLClient) {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	ctx = WithShop(ctx, Shop(server.Listener.Addr().String()))
+	ctx = WithAccessToken(ctx, "token")
+
+	client := &GraphQLClient{
+		HTTPClient: server.Client(),
+	}
+
+	return ctx, client
+}
+
+func TestGraphQLClientDo(t *testing.T) {
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/graphql.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		if value := req.Header.Get(headerXShopifyAccessToken); value != "token" {
+			t.Errorf("expected `%s` but got `%s`", "token", value)
+		}
+
+		body := graphQLRequest{}
+
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("expected no error but got: %s", err)
+			return
+		}
+
+		if body.Variables["id"] != "gid://shopify/Product/1" {
+			t.Errorf("unexpected variables: %#v", body.Variables)
+		}
+
+		w.Write([]byte(`{"data":{"product":{"title":"Shoes"}}}`))
+	}))
+
+	result := struct {
+		Product struct {
+			Title string `json:"title"`
+		} `json:"product"`
+	}{}
+
+	err := client.Do(ctx, `query($id: ID!) { product(id: $id) { title } }`, map[string]interface{}{"id": "gid://shopify/Product/1"}, &result)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if result.Product.Title != "Shoes" {
+		t.Errorf("expected `%s` but got `%s`", "Shoes", result.Product.Title)
+	}
+}
+
+func TestGraphQLClientErrors(t *testing.T) {
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data":null,"errors":[{"message":"Field 'foo' doesn't exist","locations":[{"line":1,"column":3}],"extensions":{"code":"undefinedField"}}]}`))
+	}))
+
+	err := client.Do(ctx, `{ foo }`, nil, nil)
+
+	graphQLErrors, ok := err.(GraphQLErrors)
+
+	if !ok {
+		t.Fatalf("expected GraphQL errors but got: %v", err)
+	}
+
+	if !graphQLErrors.HasCode("undefinedField") {
+		t.Errorf("expected the `undefinedField` code")
+	}
+
+	if graphQLErrors[0].Locations[0].Line != 1 {
+		t.Errorf("expected %d but got %d", 1, graphQLErrors[0].Locations[0].Line)
+	}
+}
+
+func TestGraphQLClientUserErrors(t *testing.T) {
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"data":{"productCreate":{"product":null,"userErrors":[{"field":["title"],"message":"Title can't be blank"}]}}}`))
+	}))
+
+	err := client.Do(ctx, `mutation { productCreate(input: {}) { product { id } userErrors { field message } } }`, nil, nil)
+
+	userErrors, ok := err.(UserErrors)
+
+	if !ok {
+		t.Fatalf("expected user errors but got: %v", err)
+	}
+
+	if expected := "title: Title can't be blank"; userErrors[0].Error() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, userErrors[0].Error())
+	}
+}
+
+func TestGraphQLClientAPIError(t *testing.T) {
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`))
+	}))
+
+	if err := client.Do(ctx, `{ shop { name } }`, nil, nil); !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error but got: %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package shopify
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultGraphQLMaximumAvailable is the default size of the GraphQL Admin
+	// API cost bucket, as specified by Shopify.
+	//
+	// The actual size is updated from the responses, as Shopify Plus stores
+	// have a larger bucket.
+	DefaultGraphQLMaximumAvailable = 1000
+
+	// DefaultGraphQLRestoreRate is the default number of cost points restored
+	// per second in the GraphQL Admin API cost bucket, as specified by
+	// Shopify.
+	DefaultGraphQLRestoreRate = 50
+)
+
+// graphQLThrottledCode is the error code returned by Shopify when a query
+// was throttled.
+const graphQLThrottledCode = "THROTTLED"
+
+// GraphQLThrottleStatus represents the state of a shop GraphQL cost bucket,
+// as reported by Shopify.
+type GraphQLThrottleStatus struct {
+	MaximumAvailable   float64 `json:"maximumAvailable"`
+	CurrentlyAvailable float64 `json:"currentlyAvailable"`
+	RestoreRate        float64 `json:"restoreRate"`
+}
+
+// GraphQLCost represents the cost of a GraphQL query, as reported by Shopify
+// in the response extensions.
+type GraphQLCost struct {
+	RequestedQueryCost float64 `json:"requestedQueryCost"`
+
+	// ActualQueryCost is nil when the query was not executed, as is the case
+	// when it was throttled.
+	ActualQueryCost *float64              `json:"actualQueryCost"`
+	ThrottleStatus  GraphQLThrottleStatus `json:"throttleStatus"`
+}
+
+type graphQLExtensions struct {
+	Cost *GraphQLCost `json:"cost"`
+}
+
+// unknownGraphQLQueryCost is the cost reserved for a query whose cost is not
+// known yet, so that a burst of new queries is throttled as well.
+const unknownGraphQLQueryCost = 50
+
+// maxKnownQueryCosts is the maximum number of query costs remembered by a
+// cost bucket.
+const maxKnownQueryCosts = 1024
+
+// costBucket models the Shopify GraphQL Admin API cost bucket for a shop.
+//
+// As the cost of a query is only known once Shopify executed it, the bucket
+// remembers the requested cost of the queries it has seen to estimate the
+// cost of the next ones. Queries it has not seen yet are assumed to cost
+// unknownGraphQLQueryCost.
+//
+// It is safe for concurrent use.
+type costBucket struct {
+	lock        sync.Mutex
+	maximum     float64
+	available   float64
+	restoreRate float64
+	updatedAt   time.Time
+	queryCosts  map[string]float64
+}
+
+func newCostBucket(maximum float64, restoreRate float64) *costBucket {
+	return &costBucket{
+		maximum:     maximum,
+		available:   maximum,
+		restoreRate: restoreRate,
+		updatedAt:   time.Now(),
+		queryCosts:  map[string]float64{},
+	}
+}
+
+// restore must be called with the lock held.
+func (b *costBucket) restore() {
+	now := time.Now()
+
+	b.available += now.Sub(b.updatedAt).Seconds() * b.restoreRate
+	b.updatedAt = now
+
+	if b.available > b.maximum {
+		b.available = b.maximum
+	}
+}
+
+// reserve reserves the estimated cost of a query in the bucket, blocking
+// until enough points are available or until the context expires.
+//
+// The reserved cost is returned, so that it can be given back to update.
+func (b *costBucket) reserve(ctx context.Context, query string) (float64, error) {
+	for {
+		b.lock.Lock()
+		b.restore()
+
+		cost, ok := b.queryCosts[query]
+
+		if !ok {
+			cost = unknownGraphQLQueryCost
+		}
+
+		// A query that costs more than the bucket size will be rejected by
+		// Shopify anyway: there is no point in waiting for more.
+		if cost > b.maximum {
+			cost = b.maximum
+		}
+
+		if cost <= b.available {
+			b.available -= cost
+			b.lock.Unlock()
+
+			return cost, nil
+		}
+
+		delay := time.Duration((cost - b.available) / b.restoreRate * float64(time.Second))
+		b.lock.Unlock()
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// release gives back a reserved cost, for queries that never reached
+// Shopify.
+func (b *costBucket) release(reserved float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.restore()
+	b.available += reserved
+
+	if b.available > b.maximum {
+		b.available = b.maximum
+	}
+}
+
+// update updates the bucket with the cost reported by Shopify for a query
+// whose estimated cost was reserved.
+//
+// As concurrent queries may still be in flight, the number of available
+// points is never raised above the local estimate by an update: it is only
+// restored over time.
+func (b *costBucket) update(query string, reserved float64, cost *GraphQLCost) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.restore()
+
+	if cost == nil {
+		return
+	}
+
+	if _, ok := b.queryCosts[query]; !ok && len(b.queryCosts) >= maxKnownQueryCosts {
+		b.queryCosts = map[string]float64{}
+	}
+
+	b.queryCosts[query] = cost.RequestedQueryCost
+
+	// Refund the difference between what we reserved and what was actually
+	// spent, which is nothing if the query was throttled.
+	actual := 0.0
+
+	if cost.ActualQueryCost != nil {
+		actual = *cost.ActualQueryCost
+	}
+
+	b.available += reserved - actual
+
+	status := cost.ThrottleStatus
+
+	if status.MaximumAvailable > 0 {
+		b.maximum = status.MaximumAvailable
+	}
+
+	if status.RestoreRate > 0 {
+		b.restoreRate = status.RestoreRate
+	}
+
+	// The throttle status is missing from some responses.
+	if status.MaximumAvailable > 0 && status.CurrentlyAvailable < b.available {
+		b.available = status.CurrentlyAvailable
+	}
+
+	if b.available > b.maximum {
+		b.available = b.maximum
+	}
+}
+
+// costBuckets holds the cost buckets of all shops, so that they are shared by
+// all the GraphQLClient instances.
+var costBuckets = struct {
+	sync.Mutex
+	buckets map[Shop]*costBucket
+}{
+	buckets: map[Shop]*costBucket{},
+}
+
+func getCostBucket(shop Shop) *costBucket {
+	costBuckets.Lock()
+	defer costBuckets.Unlock()
+
+	bucket, ok := costBuckets.buckets[shop]
+
+	if !ok {
+		bucket = newCostBucket(DefaultGraphQLMaximumAvailable, DefaultGraphQLRestoreRate)
+		costBuckets.buckets[shop] = bucket
+	}
+
+	return bucket
+}
@@ -0,0 +1,133 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCostBucket(t *testing.T) {
+	ctx := context.Background()
+	bucket := newCostBucket(100, 1000)
+	query := "{ shop { name } }"
+
+	reserved, err := bucket.reserve(ctx, query)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if reserved != unknownGraphQLQueryCost {
+		t.Errorf("expected %d but got %f", unknownGraphQLQueryCost, reserved)
+	}
+
+	actual := 40.0
+	bucket.update(query, reserved, &GraphQLCost{
+		RequestedQueryCost: 50,
+		ActualQueryCost:    &actual,
+		ThrottleStatus: GraphQLThrottleStatus{
+			MaximumAvailable:   100,
+			CurrentlyAvailable: 10,
+			RestoreRate:        1000,
+		},
+	})
+
+	if bucket.available > 11 {
+		t.Errorf("expected about %d available but got %f", 10, bucket.available)
+	}
+
+	start := time.Now()
+	reserved, err = bucket.reserve(ctx, query)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if reserved != 50 {
+		t.Errorf("expected %d but got %f", 50, reserved)
+	}
+
+	// 40 points must be restored at a rate of 1000/s.
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("expected the bucket to block but it only took %s", elapsed)
+	}
+}
+
+func TestCostBucketUnknownQueries(t *testing.T) {
+	ctx := context.Background()
+	bucket := newCostBucket(100, 1)
+
+	for i := 0; i < 2; i++ {
+		if _, err := bucket.reserve(ctx, "q"); err != nil {
+			t.Fatalf("expected no error but got: %s", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := bucket.reserve(ctx, "q"); err != context.DeadlineExceeded {
+		t.Errorf("expected `%s` but got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestCostBucketWithoutThrottleStatus(t *testing.T) {
+	bucket := newCostBucket(100, 1)
+	actual := 10.0
+
+	bucket.update("q", 10, &GraphQLCost{
+		RequestedQueryCost: 10,
+		ActualQueryCost:    &actual,
+	})
+
+	if bucket.available < 99 {
+		t.Errorf("expected about %d available but got %f", 100, bucket.available)
+	}
+}
+
+func TestCostBucketCancellation(t *testing.T) {
+	bucket := newCostBucket(100, 1)
+	bucket.queryCosts["q"] = 50
+	bucket.available = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := bucket.reserve(ctx, "q"); err != context.DeadlineExceeded {
+		t.Errorf("expected `%s` but got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestGraphQLClientThrottled(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestGraphQLClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+
+		if attempts == 1 {
+			w.Write([]byte(`{"errors":[{"message":"Throttled","extensions":{"code":"THROTTLED","documentation":"https://shopify.dev/api/usage/rate-limits"}}],"extensions":{"cost":{"requestedQueryCost":20,"actualQueryCost":null,"throttleStatus":{"maximumAvailable":1000.0,"currentlyAvailable":0,"restoreRate":1000.0}}}}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{"shop":{"name":"Shop"}},"extensions":{"cost":{"requestedQueryCost":20,"actualQueryCost":1,"throttleStatus":{"maximumAvailable":1000.0,"currentlyAvailable":999,"restoreRate":1000.0}}}}`))
+	}))
+
+	result := struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}{}
+
+	if err := client.Do(ctx, `{ shop { name } }`, nil, &result); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected %d attempts but got %d", 2, attempts)
+	}
+
+	if result.Shop.Name != "Shop" {
+		t.Errorf("expected `%s` but got `%s`", "Shop", result.Shop.Name)
+	}
+}
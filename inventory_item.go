@@ -0,0 +1,159 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxInventoryItemIDs is the maximum number of inventory item IDs that
+// Shopify accepts when listing inventory items.
+const MaxInventoryItemIDs = 100
+
+// InventoryItemID is an ID of an inventory item.
+type InventoryItemID int
+
+// CountryHarmonizedSystemCode represents the harmonized system code of an
+// inventory item for a specific country.
+type CountryHarmonizedSystemCode struct {
+	CountryCode          string `json:"country_code"`
+	HarmonizedSystemCode string `json:"harmonized_system_code"`
+}
+
+// InventoryItem represents the physical good behind a product variant.
+//
+// Cost is in the shop currency, which Shopify does not return with the
+// inventory item: its currency is empty.
+//
+// Empty fields are omitted when updating an inventory item, so that only the
+// specified fields are changed.
+type InventoryItem struct {
+	ID                           InventoryItemID               `json:"id,omitempty"`
+	SKU                          string                        `json:"sku,omitempty"`
+	Cost                         *Money                        `json:"cost,omitempty"`
+	Tracked                      *bool                         `json:"tracked,omitempty"`
+	RequiresShipping             *bool                         `json:"requires_shipping,omitempty"`
+	CountryCodeOfOrigin          string                        `json:"country_code_of_origin,omitempty"`
+	ProvinceCodeOfOrigin         string                        `json:"province_code_of_origin,omitempty"`
+	HarmonizedSystemCode         string                        `json:"harmonized_system_code,omitempty"`
+	CountryHarmonizedSystemCodes []CountryHarmonizedSystemCode `json:"country_harmonized_system_codes,omitempty"`
+	AdminGraphQLAPIID            string                        `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt                    *time.Time                    `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time                    `json:"updated_at,omitempty"`
+}
+
+type inventoryItemEnvelope struct {
+	InventoryItem InventoryItem `json:"inventory_item"`
+}
+
+// GetAllInventoryItems retrieves the inventory items with the specified IDs.
+//
+// Any number of IDs can be specified: they are split into batches of
+// MaxInventoryItemIDs.
+func (c *AdminClient) GetAllInventoryItems(ctx context.Context, ids []InventoryItemID, fields SelectedFields) ([]InventoryItem, error) {
+	var result []InventoryItem
+
+	for start := 0; start < len(ids); start += MaxInventoryItemIDs {
+		end := start + MaxInventoryItemIDs
+
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		pagination := &Pagination{
+			Limit: MaxLimit,
+		}
+
+		for page := 1; pagination != nil; page++ {
+			items, links, err := c.GetInventoryItems(ctx, ids[start:end], pagination, fields)
+
+			if err != nil {
+				return nil, fmt.Errorf("fetching page %d of IDs %d to %d: %w", page, start, end-1, err)
+			}
+
+			result = append(result, items...)
+			pagination = links.Next
+		}
+	}
+
+	return result, nil
+}
+
+// GetInventoryItems retrieves a list of the inventory items with the
+// specified IDs.
+//
+// At most MaxInventoryItemIDs IDs can be specified. To fetch any number of
+// inventory items, use GetAllInventoryItems.
+func (c *AdminClient) GetInventoryItems(ctx context.Context, ids []InventoryItemID, pagination *Pagination, fields SelectedFields) ([]InventoryItem, *PageLinks, error) {
+	if len(ids) > MaxInventoryItemIDs {
+		return nil, nil, fmt.Errorf("too many inventory item IDs (%d > %d)", len(ids), MaxInventoryItemIDs)
+	}
+
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		values.Set("ids", joinInventoryItemIDs(ids))
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		InventoryItems []InventoryItem `json:"inventory_items"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "inventory_items.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.InventoryItems, parsePageLinks(header), nil
+}
+
+// GetInventoryItem fetches an inventory item by ID.
+//
+// If no such inventory item exists, a nil inventory item and no error is
+// returned.
+func (c *AdminClient) GetInventoryItem(ctx context.Context, id InventoryItemID, fields SelectedFields) (*InventoryItem, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &inventoryItemEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("inventory_items/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.InventoryItem, nil
+}
+
+// UpdateInventoryItem updates an inventory item.
+func (c *AdminClient) UpdateInventoryItem(ctx context.Context, inventoryItem InventoryItem) (*InventoryItem, error) {
+	body := &inventoryItemEnvelope{InventoryItem: inventoryItem}
+	result := &inventoryItemEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("inventory_items/%d.json", inventoryItem.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.InventoryItem, nil
+}
+
+func joinInventoryItemIDs(ids []InventoryItemID) string {
+	s := make([]string, len(ids))
+
+	for i, id := range ids {
+		s[i] = strconv.Itoa(int(id))
+	}
+
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,265 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxInventoryLevelFilterIDs is the maximum number of inventory item IDs, and
+// of location IDs, that Shopify accepts when listing inventory levels.
+const MaxInventoryLevelFilterIDs = 50
+
+// InventoryLevel represents the quantity of an inventory item at a location.
+type InventoryLevel struct {
+	InventoryItemID InventoryItemID `json:"inventory_item_id"`
+	LocationID      LocationID      `json:"location_id"`
+
+	// Available is the available quantity. It is nil if the inventory item
+	// is not tracked.
+	Available *int `json:"available"`
+
+	AdminGraphQLAPIID string     `json:"admin_graphql_api_id,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+type inventoryLevelEnvelope struct {
+	InventoryLevel InventoryLevel `json:"inventory_level"`
+}
+
+// InventoryLevelFilter represents filters to apply when listing inventory
+// levels.
+//
+// At least one inventory item ID or location ID must be specified.
+type InventoryLevelFilter struct {
+	InventoryItemIDs []InventoryItemID
+	LocationIDs      []LocationID
+	UpdatedAtMin     time.Time
+}
+
+func (f *InventoryLevelFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.InventoryItemIDs) > 0 {
+		values.Set("inventory_item_ids", joinInventoryItemIDs(f.InventoryItemIDs))
+	}
+
+	if len(f.LocationIDs) > 0 {
+		ids := make([]string, len(f.LocationIDs))
+
+		for i, id := range f.LocationIDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("location_ids", strings.Join(ids, ","))
+	}
+
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+}
+
+// batches splits the filter into filters that have at most
+// MaxInventoryLevelFilterIDs inventory item IDs and location IDs each.
+func (f InventoryLevelFilter) batches() []InventoryLevelFilter {
+	var itemBatches [][]InventoryItemID
+
+	for start := 0; start < len(f.InventoryItemIDs); start += MaxInventoryLevelFilterIDs {
+		end := start + MaxInventoryLevelFilterIDs
+
+		if end > len(f.InventoryItemIDs) {
+			end = len(f.InventoryItemIDs)
+		}
+
+		itemBatches = append(itemBatches, f.InventoryItemIDs[start:end])
+	}
+
+	var locationBatches [][]LocationID
+
+	for start := 0; start < len(f.LocationIDs); start += MaxInventoryLevelFilterIDs {
+		end := start + MaxInventoryLevelFilterIDs
+
+		if end > len(f.LocationIDs) {
+			end = len(f.LocationIDs)
+		}
+
+		locationBatches = append(locationBatches, f.LocationIDs[start:end])
+	}
+
+	// A nil batch stands for "no filter" on that dimension.
+	if len(itemBatches) == 0 {
+		itemBatches = [][]InventoryItemID{nil}
+	}
+
+	if len(locationBatches) == 0 {
+		locationBatches = [][]LocationID{nil}
+	}
+
+	result := make([]InventoryLevelFilter, 0, len(itemBatches)*len(locationBatches))
+
+	for _, items := range itemBatches {
+		for _, locations := range locationBatches {
+			result = append(result, InventoryLevelFilter{
+				InventoryItemIDs: items,
+				LocationIDs:      locations,
+				UpdatedAtMin:     f.UpdatedAtMin,
+			})
+		}
+	}
+
+	return result
+}
+
+// GetAllInventoryLevels retrieves a list of all inventory levels matching
+// the filter.
+//
+// Any number of inventory item IDs and location IDs can be specified: they
+// are split into batches of MaxInventoryLevelFilterIDs, which are fetched
+// one after the other.
+func (c *AdminClient) GetAllInventoryLevels(ctx context.Context, filter InventoryLevelFilter) ([]InventoryLevel, error) {
+	var result []InventoryLevel
+
+	for i, batch := range filter.batches() {
+		batch := batch
+		pagination := &Pagination{
+			Limit: MaxLimit,
+		}
+
+		for page := 1; pagination != nil; page++ {
+			levels, links, err := c.GetInventoryLevels(ctx, &batch, pagination)
+
+			if err != nil {
+				return nil, fmt.Errorf("fetching page %d of batch %d: %w", page, i+1, err)
+			}
+
+			result = append(result, levels...)
+			pagination = links.Next
+		}
+	}
+
+	return result, nil
+}
+
+// GetInventoryLevels retrieves a list of inventory levels matching the
+// filter.
+//
+// At most MaxInventoryLevelFilterIDs inventory item IDs and location IDs can
+// be specified. To fetch the inventory levels of any number of inventory
+// items or locations, use GetAllInventoryLevels.
+func (c *AdminClient) GetInventoryLevels(ctx context.Context, filter *InventoryLevelFilter, pagination *Pagination) ([]InventoryLevel, *PageLinks, error) {
+	if filter != nil {
+		if len(filter.InventoryItemIDs) > MaxInventoryLevelFilterIDs {
+			return nil, nil, fmt.Errorf("too many inventory item IDs (%d > %d)", len(filter.InventoryItemIDs), MaxInventoryLevelFilterIDs)
+		}
+
+		if len(filter.LocationIDs) > MaxInventoryLevelFilterIDs {
+			return nil, nil, fmt.Errorf("too many location IDs (%d > %d)", len(filter.LocationIDs), MaxInventoryLevelFilterIDs)
+		}
+	}
+
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+
+	result := &struct {
+		InventoryLevels []InventoryLevel `json:"inventory_levels"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "inventory_levels.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.InventoryLevels, parsePageLinks(header), nil
+}
+
+// AdjustInventoryLevel adjusts the available quantity of an inventory item
+// at a location by a relative amount, which may be negative.
+func (c *AdminClient) AdjustInventoryLevel(ctx context.Context, inventoryItemID InventoryItemID, locationID LocationID, adjustment int) (*InventoryLevel, error) {
+	body := &struct {
+		InventoryItemID     InventoryItemID `json:"inventory_item_id"`
+		LocationID          LocationID      `json:"location_id"`
+		AvailableAdjustment int             `json:"available_adjustment"`
+	}{
+		InventoryItemID:     inventoryItemID,
+		LocationID:          locationID,
+		AvailableAdjustment: adjustment,
+	}
+	result := &inventoryLevelEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, "inventory_levels/adjust.json", nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.InventoryLevel, nil
+}
+
+// SetInventoryLevel sets the available quantity of an inventory item at a
+// location.
+//
+// If disconnectIfNecessary is true, the inventory item is disconnected from
+// any location that cannot stock it alongside the specified one.
+func (c *AdminClient) SetInventoryLevel(ctx context.Context, inventoryItemID InventoryItemID, locationID LocationID, available int, disconnectIfNecessary bool) (*InventoryLevel, error) {
+	body := &struct {
+		InventoryItemID       InventoryItemID `json:"inventory_item_id"`
+		LocationID            LocationID      `json:"location_id"`
+		Available             int             `json:"available"`
+		DisconnectIfNecessary bool            `json:"disconnect_if_necessary,omitempty"`
+	}{
+		InventoryItemID:       inventoryItemID,
+		LocationID:            locationID,
+		Available:             available,
+		DisconnectIfNecessary: disconnectIfNecessary,
+	}
+	result := &inventoryLevelEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "inventory_levels/set.json", nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.InventoryLevel, nil
+}
+
+// ConnectInventoryLevel connects an inventory item to a location, so that it
+// can be stocked there.
+//
+// If relocateIfNecessary is true, the inventory item is relocated from any
+// location that cannot stock it alongside the specified one.
+func (c *AdminClient) ConnectInventoryLevel(ctx context.Context, inventoryItemID InventoryItemID, locationID LocationID, relocateIfNecessary bool) (*InventoryLevel, error) {
+	body := &struct {
+		InventoryItemID     InventoryItemID `json:"inventory_item_id"`
+		LocationID          LocationID      `json:"location_id"`
+		RelocateIfNecessary bool            `json:"relocate_if_necessary,omitempty"`
+	}{
+		InventoryItemID:     inventoryItemID,
+		LocationID:          locationID,
+		RelocateIfNecessary: relocateIfNecessary,
+	}
+	result := &inventoryLevelEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "inventory_levels/connect.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.InventoryLevel, nil
+}
+
+// DeleteInventoryLevel disconnects an inventory item from a location.
+func (c *AdminClient) DeleteInventoryLevel(ctx context.Context, inventoryItemID InventoryItemID, locationID LocationID) error {
+	values := url.Values{}
+	values.Set("inventory_item_id", strconv.Itoa(int(inventoryItemID)))
+	values.Set("location_id", strconv.Itoa(int(locationID)))
+
+	_, err := c.doJSON(ctx, http.MethodDelete, "inventory_levels.json", values, nil, http.StatusNoContent, nil)
+
+	return err
+}
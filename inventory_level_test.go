@@ -0,0 +1,68 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGetAllInventoryLevelsBatches(t *testing.T) {
+	var lock sync.Mutex
+	var batchSizes []int
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ids := strings.Split(req.URL.Query().Get("inventory_item_ids"), ",")
+
+		if expected := "7"; req.URL.Query().Get("location_ids") != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Query().Get("location_ids"))
+		}
+
+		lock.Lock()
+		batchSizes = append(batchSizes, len(ids))
+		lock.Unlock()
+
+		var levels []string
+
+		for _, id := range ids {
+			levels = append(levels, fmt.Sprintf(`{"inventory_item_id":%s,"location_id":7,"available":1}`, id))
+		}
+
+		fmt.Fprintf(w, `{"inventory_levels":[%s]}`, strings.Join(levels, ","))
+	}))
+
+	filter := InventoryLevelFilter{
+		LocationIDs: []LocationID{7},
+	}
+
+	for i := 1; i <= 120; i++ {
+		filter.InventoryItemIDs = append(filter.InventoryItemIDs, InventoryItemID(i))
+	}
+
+	levels, err := client.GetAllInventoryLevels(ctx, filter)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(levels) != 120 {
+		t.Errorf("expected 120 inventory levels but got %d", len(levels))
+	}
+
+	if expected := "[50 50 20]"; fmt.Sprint(batchSizes) != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, fmt.Sprint(batchSizes))
+	}
+}
+
+func TestGetInventoryLevelsTooManyIDs(t *testing.T) {
+	client := &AdminClient{}
+	filter := &InventoryLevelFilter{
+		InventoryItemIDs: make([]InventoryItemID, MaxInventoryLevelFilterIDs+1),
+	}
+
+	if _, _, err := client.GetInventoryLevels(context.Background(), filter, nil); err == nil {
+		t.Errorf("expected an error")
+	}
+}
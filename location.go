@@ -0,0 +1,97 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// LocationID is an ID of a location.
+type LocationID int
+
+// Location represents a place where inventory is stocked, such as a store, a
+// warehouse or a fulfillment service.
+type Location struct {
+	ID                    LocationID `json:"id,omitempty"`
+	Name                  string     `json:"name,omitempty"`
+	Active                bool       `json:"active"`
+	Legacy                bool       `json:"legacy"`
+	Address1              string     `json:"address1,omitempty"`
+	Address2              string     `json:"address2,omitempty"`
+	City                  string     `json:"city,omitempty"`
+	Zip                   string     `json:"zip,omitempty"`
+	Province              string     `json:"province,omitempty"`
+	ProvinceCode          string     `json:"province_code,omitempty"`
+	Country               string     `json:"country,omitempty"`
+	CountryCode           string     `json:"country_code,omitempty"`
+	CountryName           string     `json:"country_name,omitempty"`
+	LocalizedCountryName  string     `json:"localized_country_name,omitempty"`
+	LocalizedProvinceName string     `json:"localized_province_name,omitempty"`
+	Phone                 string     `json:"phone,omitempty"`
+	AdminGraphQLAPIID     string     `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+type locationEnvelope struct {
+	Location Location `json:"location"`
+}
+
+// GetLocations retrieves the list of all the locations.
+func (c *AdminClient) GetLocations(ctx context.Context) ([]Location, error) {
+	result := &struct {
+		Locations []Location `json:"locations"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, "locations.json", nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Locations, nil
+}
+
+// GetLocationsCount retrieves the count of all the locations.
+func (c *AdminClient) GetLocationsCount(ctx context.Context) (int, error) {
+	return c.getCount(ctx, "locations/count.json", nil)
+}
+
+// GetLocation fetches a location by ID.
+//
+// If no such location exists, a nil location and no error is returned.
+func (c *AdminClient) GetLocation(ctx context.Context, id LocationID) (*Location, error) {
+	result := &locationEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("locations/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Location, nil
+}
+
+// GetLocationInventoryLevels retrieves a list of the inventory levels of a
+// location.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllInventoryLevels.
+func (c *AdminClient) GetLocationInventoryLevels(ctx context.Context, id LocationID, pagination *Pagination) ([]InventoryLevel, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+
+	result := &struct {
+		InventoryLevels []InventoryLevel `json:"inventory_levels"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("locations/%d/inventory_levels.json", id), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.InventoryLevels, parsePageLinks(header), nil
+}
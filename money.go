@@ -0,0 +1,374 @@
+package shopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// CurrencyCode is an ISO 4217 currency code, such as "USD".
+type CurrencyCode string
+
+// currencyDigits lists the currencies whose minor unit is not the cent.
+var currencyDigits = map[CurrencyCode]int{
+	"BHD": 3,
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"RWF": 0,
+	"TND": 3,
+	"UGX": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+}
+
+// Digits returns the number of digits of the minor unit of the currency: 2
+// for USD, 0 for JPY or 3 for KWD.
+//
+// Unknown currencies are assumed to have 2 digits.
+func (c CurrencyCode) Digits() int {
+	if digits, ok := currencyDigits[c]; ok {
+		return digits
+	}
+
+	return 2
+}
+
+// Money represents an exact amount of money in a currency.
+type Money struct {
+	Amount       Decimal      `json:"amount"`
+	CurrencyCode CurrencyCode `json:"currency_code"`
+}
+
+// NewMoney returns an amount of money.
+func NewMoney(amount Decimal, currencyCode CurrencyCode) Money {
+	return Money{
+		Amount:       amount,
+		CurrencyCode: currencyCode,
+	}
+}
+
+// ParseMoney parses a decimal amount, such as "19.99", in a currency.
+func ParseMoney(amount string, currencyCode CurrencyCode) (Money, error) {
+	d, err := ParseDecimal(amount)
+
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(d, currencyCode), nil
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.CurrencyCode != other.CurrencyCode {
+		return fmt.Errorf("currency mismatch: %s and %s", m.CurrencyCode, other.CurrencyCode)
+	}
+
+	return nil
+}
+
+// Add returns m + other.
+//
+// An error is returned if the currencies differ.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(m.Amount.Add(other.Amount), m.CurrencyCode), nil
+}
+
+// Sub returns m - other.
+//
+// An error is returned if the currencies differ.
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(m.Amount.Sub(other.Amount), m.CurrencyCode), nil
+}
+
+// Mul returns m multiplied by a quantity.
+func (m Money) Mul(quantity int) Money {
+	return NewMoney(m.Amount.MulInt(int64(quantity)), m.CurrencyCode)
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return NewMoney(m.Amount.Neg(), m.CurrencyCode)
+}
+
+// Round returns m rounded to the minor unit of its currency.
+func (m Money) Round() Money {
+	return NewMoney(m.Amount.Round(m.CurrencyCode.Digits()), m.CurrencyCode)
+}
+
+// Allocate splits m, rounded to the minor unit of its currency, into parts
+// proportional to the specified ratios.
+//
+// The parts always add up to the rounded amount: the minor units that
+// cannot be split evenly are given one by one to the first parts. For
+// instance, allocating 10.00 USD with ratios 1, 1 and 1 gives 3.34, 3.33 and
+// 3.33.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.New("at least one ratio is required")
+	}
+
+	total := int64(0)
+
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("invalid negative ratio %d", ratio)
+		}
+
+		total += int64(ratio)
+	}
+
+	if total == 0 {
+		return nil, errors.New("the sum of the ratios must not be zero")
+	}
+
+	scale := m.CurrencyCode.Digits()
+	units := m.Amount.Round(scale).int()
+	remainder := new(big.Int).Set(units)
+	result := make([]Money, len(ratios))
+	shares := make([]*big.Int, len(ratios))
+
+	for i, ratio := range ratios {
+		// Quo truncates toward zero, so the remainder has the sign of the
+		// amount.
+		shares[i] = new(big.Int).Quo(new(big.Int).Mul(units, big.NewInt(int64(ratio))), big.NewInt(total))
+		remainder.Sub(remainder, shares[i])
+	}
+
+	step := big.NewInt(int64(remainder.Sign()))
+
+	for i := 0; remainder.Sign() != 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+
+		shares[i].Add(shares[i], step)
+		remainder.Sub(remainder, step)
+	}
+
+	for i, share := range shares {
+		result[i] = NewMoney(Decimal{unscaled: share, scale: scale}, m.CurrencyCode)
+	}
+
+	return result, nil
+}
+
+// Split splits m into n parts that add up to m, rounded to the minor unit of
+// its currency. See Allocate.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid number of parts %d", n)
+	}
+
+	ratios := make([]int, n)
+
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return m.Allocate(ratios...)
+}
+
+// Cmp compares m and other and returns -1, 0 or +1.
+//
+// An error is returned if the currencies differ.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+
+	return m.Amount.Cmp(other.Amount), nil
+}
+
+// Equal returns true if m and other have the same currency and represent
+// the same amount.
+func (m Money) Equal(other Money) bool {
+	return m.CurrencyCode == other.CurrencyCode && m.Amount.Equal(other.Amount)
+}
+
+// IsZero returns true if the amount is 0.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// String returns the amount, rounded to the minor unit of its currency, and
+// the currency code, such as "19.99 USD".
+func (m Money) String() string {
+	if m.CurrencyCode == "" {
+		return m.Amount.String()
+	}
+
+	return m.Round().Amount.String() + " " + string(m.CurrencyCode)
+}
+
+// moneyObject is the JSON object form of Money.
+type moneyObject struct {
+	Amount       Decimal      `json:"amount"`
+	CurrencyCode CurrencyCode `json:"currency_code"`
+}
+
+// MarshalJSON implements JSON marshalling.
+//
+// Money is marshalled as its bare amount, such as "19.99", which is how the
+// REST resources send amounts: their currency is indicated by another field
+// of the resource. MoneySet values are marshalled in the object form.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Amount)
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// Both the REST (`currency_code`) and the GraphQL (`currencyCode`) object
+// forms are supported, and the amount can be either a string or a number.
+//
+// A bare amount, such as "19.99", is unmarshalled with no currency. The
+// resources that contain it then set their own currency, such as
+// Order.Currency.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if b = bytes.TrimSpace(b); len(b) == 0 || b[0] != '{' {
+		*m = Money{}
+
+		return m.Amount.UnmarshalJSON(b)
+	}
+
+	payload := struct {
+		Amount              Decimal      `json:"amount"`
+		CurrencyCode        CurrencyCode `json:"currency_code"`
+		GraphQLCurrencyCode CurrencyCode `json:"currencyCode"`
+	}{}
+
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+
+	m.Amount = payload.Amount
+	m.CurrencyCode = payload.CurrencyCode
+
+	if m.CurrencyCode == "" {
+		m.CurrencyCode = payload.GraphQLCurrencyCode
+	}
+
+	return nil
+}
+
+// MoneySet represents an amount of money in both the shop currency and the
+// currency presented to the customer, which differ in multi-currency shops.
+type MoneySet struct {
+	ShopMoney        Money `json:"shop_money"`
+	PresentmentMoney Money `json:"presentment_money"`
+}
+
+// MarshalJSON implements JSON marshalling.
+func (s MoneySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ShopMoney        moneyObject `json:"shop_money"`
+		PresentmentMoney moneyObject `json:"presentment_money"`
+	}{
+		ShopMoney:        moneyObject(s.ShopMoney),
+		PresentmentMoney: moneyObject(s.PresentmentMoney),
+	})
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// Both the REST (`shop_money`) and the GraphQL (`shopMoney`) forms are
+// supported.
+func (s *MoneySet) UnmarshalJSON(b []byte) error {
+	payload := struct {
+		ShopMoney               *Money `json:"shop_money"`
+		PresentmentMoney        *Money `json:"presentment_money"`
+		GraphQLShopMoney        *Money `json:"shopMoney"`
+		GraphQLPresentmentMoney *Money `json:"presentmentMoney"`
+	}{}
+
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+
+	*s = MoneySet{}
+
+	if payload.ShopMoney != nil {
+		s.ShopMoney = *payload.ShopMoney
+	} else if payload.GraphQLShopMoney != nil {
+		s.ShopMoney = *payload.GraphQLShopMoney
+	}
+
+	if payload.PresentmentMoney != nil {
+		s.PresentmentMoney = *payload.PresentmentMoney
+	} else if payload.GraphQLPresentmentMoney != nil {
+		s.PresentmentMoney = *payload.GraphQLPresentmentMoney
+	}
+
+	return nil
+}
+
+var (
+	moneyType       = reflect.TypeOf(Money{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// setMoneyCurrency sets the currency of the Money values in v that have none.
+//
+// REST resources send amounts as bare strings, in a currency indicated by
+// another field of the resource. Nested values that unmarshal themselves,
+// such as nested resources and MoneySet values, are left untouched.
+func setMoneyCurrency(v reflect.Value, currency CurrencyCode) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			setMoneyCurrency(v.Elem(), currency)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			setMoneyCurrency(v.Index(i), currency)
+		}
+	case reflect.Struct:
+		if v.Type() == moneyType {
+			if m := v.Addr().Interface().(*Money); m.CurrencyCode == "" {
+				m.CurrencyCode = currency
+			}
+
+			return
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			t := field.Type()
+
+			for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+				t = t.Elem()
+			}
+
+			if !field.CanSet() || (t != moneyType && reflect.PtrTo(t).Implements(unmarshalerType)) {
+				continue
+			}
+
+			setMoneyCurrency(field, currency)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package shopify
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMoneyAllocate(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Money    Money
+		Ratios   []int
+		Expected string
+	}{
+		{"even", NewMoney(MustParseDecimal("10"), "USD"), []int{1, 1, 1}, "3.34 USD,3.33 USD,3.33 USD"},
+		{"weighted", NewMoney(MustParseDecimal("0.05"), "USD"), []int{3, 7}, "0.02 USD,0.03 USD"},
+		{"zero ratio", NewMoney(MustParseDecimal("1.01"), "EUR"), []int{0, 1, 1}, "0.00 EUR,0.51 EUR,0.50 EUR"},
+		{"negative", NewMoney(MustParseDecimal("-10"), "USD"), []int{1, 1, 1}, "-3.34 USD,-3.33 USD,-3.33 USD"},
+		{"no minor unit", NewMoney(MustParseDecimal("100"), "JPY"), []int{1, 1, 1}, "34 JPY,33 JPY,33 JPY"},
+		{"three digits", NewMoney(MustParseDecimal("1"), "KWD"), []int{1, 2}, "0.334 KWD,0.666 KWD"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			parts, err := testCase.Money.Allocate(testCase.Ratios...)
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %s", err)
+			}
+
+			var s []string
+
+			for _, part := range parts {
+				s = append(s, part.String())
+			}
+
+			if strings.Join(s, ",") != testCase.Expected {
+				t.Errorf("expected `%s` but got `%s`", testCase.Expected, strings.Join(s, ","))
+			}
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	price := NewMoney(MustParseDecimal("19.99"), "USD")
+	total, err := price.Mul(3).Sub(NewMoney(MustParseDecimal("0.97"), "USD"))
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if expected := "59.00 USD"; total.String() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, total.String())
+	}
+
+	if _, err := price.Add(NewMoney(MustParseDecimal("1"), "EUR")); err == nil {
+		t.Errorf("expected an error when adding different currencies")
+	}
+
+	if cmp, err := price.Cmp(total); err != nil || cmp != -1 {
+		t.Errorf("expected `-1` but got `%d` (%v)", cmp, err)
+	}
+}
+
+func TestMoneySetUnmarshalJSON(t *testing.T) {
+	testCases := []string{
+		`{"shop_money":{"amount":"19.99","currency_code":"USD"},"presentment_money":{"amount":17.5,"currency_code":"EUR"}}`,
+		`{"shopMoney":{"amount":"19.99","currencyCode":"USD"},"presentmentMoney":{"amount":"17.50","currencyCode":"EUR"}}`,
+	}
+
+	for _, testCase := range testCases {
+		var set MoneySet
+
+		if err := json.Unmarshal([]byte(testCase), &set); err != nil {
+			t.Fatalf("expected no error but got: %s", err)
+		}
+
+		if !set.ShopMoney.Equal(NewMoney(MustParseDecimal("19.99"), "USD")) {
+			t.Errorf("unexpected shop money: %s", set.ShopMoney)
+		}
+
+		if !set.PresentmentMoney.Equal(NewMoney(MustParseDecimal("17.5"), "EUR")) {
+			t.Errorf("unexpected presentment money: %s", set.PresentmentMoney)
+		}
+	}
+}
+
+func TestMoneyMarshalJSON(t *testing.T) {
+	variant := ProductVariant{
+		PresentmentPrices: []ProductVariantPresentmentPrice{
+			{Price: NewMoney(MustParseDecimal("17.50"), "EUR")},
+		},
+	}
+	price := NewMoney(MustParseDecimal("19.99"), "USD")
+	variant.Price = &price
+
+	data, err := json.Marshal(variant)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	expected := `{"price":"19.99","presentment_prices":[{"price":{"amount":"17.50","currency_code":"EUR"}}]}`
+
+	if string(data) != expected {
+		t.Errorf("expected: %s\ngot: %s", expected, string(data))
+	}
+}
+
+func TestOrderUnmarshalJSONCurrency(t *testing.T) {
+	data := `{"currency":"USD","total_price":"19.99","total_price_set":{"shop_money":{"amount":"19.99","currency_code":"USD"},"presentment_money":{"amount":"17.50","currency_code":"EUR"}},"line_items":[{"price":"9.99","tax_lines":[{"price":"1.00"}]}],"customer":{"currency":"EUR","total_spent":"30.00"}}`
+
+	var order Order
+
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	for _, testCase := range []struct {
+		Money    *Money
+		Expected string
+	}{
+		{order.TotalPrice, "19.99 USD"},
+		{order.LineItems[0].Price, "9.99 USD"},
+		{order.LineItems[0].TaxLines[0].Price, "1.00 USD"},
+		{&order.TotalPriceSet.PresentmentMoney, "17.50 EUR"},
+		{order.Customer.TotalSpent, "30.00 EUR"},
+	} {
+		if testCase.Money.String() != testCase.Expected {
+			t.Errorf("expected `%s` but got `%s`", testCase.Expected, testCase.Money)
+		}
+	}
+}
@@ -0,0 +1,492 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrderID is an ID of an order.
+type OrderID int
+
+// OrderStatus represents the status of an order, as used to filter orders.
+type OrderStatus string
+
+const (
+	// OrderStatusOpen matches open orders.
+	OrderStatusOpen OrderStatus = "open"
+	// OrderStatusClosed matches closed orders.
+	OrderStatusClosed OrderStatus = "closed"
+	// OrderStatusCancelled matches cancelled orders.
+	OrderStatusCancelled OrderStatus = "cancelled"
+	// OrderStatusAny matches orders of any status.
+	OrderStatusAny OrderStatus = "any"
+)
+
+// OrderFinancialStatus represents the financial status of an order.
+type OrderFinancialStatus string
+
+const (
+	// OrderFinancialStatusPending indicates that the payments are pending.
+	OrderFinancialStatusPending OrderFinancialStatus = "pending"
+	// OrderFinancialStatusAuthorized indicates that the payments have been
+	// authorized.
+	OrderFinancialStatusAuthorized OrderFinancialStatus = "authorized"
+	// OrderFinancialStatusPartiallyPaid indicates that the order has been
+	// partially paid.
+	OrderFinancialStatusPartiallyPaid OrderFinancialStatus = "partially_paid"
+	// OrderFinancialStatusPaid indicates that the order has been paid.
+	OrderFinancialStatusPaid OrderFinancialStatus = "paid"
+	// OrderFinancialStatusPartiallyRefunded indicates that the order has been
+	// partially refunded.
+	OrderFinancialStatusPartiallyRefunded OrderFinancialStatus = "partially_refunded"
+	// OrderFinancialStatusRefunded indicates that the order has been
+	// refunded.
+	OrderFinancialStatusRefunded OrderFinancialStatus = "refunded"
+	// OrderFinancialStatusVoided indicates that the payments have been
+	// voided.
+	OrderFinancialStatusVoided OrderFinancialStatus = "voided"
+
+	// OrderFinancialStatusUnpaid matches orders that are either authorized
+	// or partially paid. It can only be used to filter orders.
+	OrderFinancialStatusUnpaid OrderFinancialStatus = "unpaid"
+	// OrderFinancialStatusAny matches orders of any financial status. It can
+	// only be used to filter orders.
+	OrderFinancialStatusAny OrderFinancialStatus = "any"
+)
+
+// OrderFulfillmentStatus represents the fulfillment status of an order.
+//
+// An order with no fulfillment has an empty fulfillment status.
+type OrderFulfillmentStatus string
+
+const (
+	// OrderFulfillmentStatusFulfilled indicates that all the line items have
+	// been fulfilled.
+	OrderFulfillmentStatusFulfilled OrderFulfillmentStatus = "fulfilled"
+	// OrderFulfillmentStatusPartial indicates that some of the line items
+	// have been fulfilled.
+	OrderFulfillmentStatusPartial OrderFulfillmentStatus = "partial"
+	// OrderFulfillmentStatusRestocked indicates that the line items have been
+	// restocked.
+	OrderFulfillmentStatusRestocked OrderFulfillmentStatus = "restocked"
+)
+
+// OrderFulfillmentStatusFilter represents a fulfillment status, as used to
+// filter orders.
+type OrderFulfillmentStatusFilter string
+
+const (
+	// OrderFulfillmentStatusFilterShipped matches fulfilled orders.
+	OrderFulfillmentStatusFilterShipped OrderFulfillmentStatusFilter = "shipped"
+	// OrderFulfillmentStatusFilterPartial matches partially fulfilled
+	// orders.
+	OrderFulfillmentStatusFilterPartial OrderFulfillmentStatusFilter = "partial"
+	// OrderFulfillmentStatusFilterUnshipped matches orders with no
+	// fulfillment.
+	OrderFulfillmentStatusFilterUnshipped OrderFulfillmentStatusFilter = "unshipped"
+	// OrderFulfillmentStatusFilterUnfulfilled matches orders that are either
+	// unshipped or partially fulfilled.
+	OrderFulfillmentStatusFilterUnfulfilled OrderFulfillmentStatusFilter = "unfulfilled"
+	// OrderFulfillmentStatusFilterAny matches orders of any fulfillment
+	// status.
+	OrderFulfillmentStatusFilterAny OrderFulfillmentStatusFilter = "any"
+)
+
+// OrderCancelReason represents the reason why an order was cancelled.
+type OrderCancelReason string
+
+const (
+	// OrderCancelReasonCustomer indicates that the customer cancelled the
+	// order.
+	OrderCancelReasonCustomer OrderCancelReason = "customer"
+	// OrderCancelReasonFraud indicates that the order was fraudulent.
+	OrderCancelReasonFraud OrderCancelReason = "fraud"
+	// OrderCancelReasonInventory indicates that items were not available.
+	OrderCancelReasonInventory OrderCancelReason = "inventory"
+	// OrderCancelReasonDeclined indicates that the payment was declined.
+	OrderCancelReasonDeclined OrderCancelReason = "declined"
+	// OrderCancelReasonOther indicates another reason.
+	OrderCancelReasonOther OrderCancelReason = "other"
+)
+
+// TaxLine represents a tax applied to an order, a line item or a shipping
+// line.
+type TaxLine struct {
+	Title    string    `json:"title,omitempty"`
+	Price    *Money    `json:"price,omitempty"`
+	PriceSet *MoneySet `json:"price_set,omitempty"`
+	Rate     float64   `json:"rate,omitempty"`
+}
+
+// DiscountAllocation represents the part of a discount application that is
+// allocated to a line item or a shipping line.
+type DiscountAllocation struct {
+	Amount                   *Money    `json:"amount,omitempty"`
+	AmountSet                *MoneySet `json:"amount_set,omitempty"`
+	DiscountApplicationIndex int       `json:"discount_application_index"`
+}
+
+// DiscountApplication represents a discount applied to an order.
+type DiscountApplication struct {
+	Type             string   `json:"type,omitempty"`
+	Title            string   `json:"title,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Code             string   `json:"code,omitempty"`
+	Value            *Decimal `json:"value,omitempty"`
+	ValueType        string   `json:"value_type,omitempty"`
+	AllocationMethod string   `json:"allocation_method,omitempty"`
+	TargetSelection  string   `json:"target_selection,omitempty"`
+	TargetType       string   `json:"target_type,omitempty"`
+}
+
+// DiscountCode represents a discount code applied to an order.
+type DiscountCode struct {
+	Code   string `json:"code,omitempty"`
+	Amount *Money `json:"amount,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+// LineItemID is an ID of a line item.
+type LineItemID int
+
+// LineItemProperty represents a custom property of a line item.
+type LineItemProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LineItem represents a line item of an order.
+type LineItem struct {
+	ID                  LineItemID           `json:"id,omitempty"`
+	ProductID           ProductID            `json:"product_id,omitempty"`
+	VariantID           ProductVariantID     `json:"variant_id,omitempty"`
+	Title               string               `json:"title,omitempty"`
+	VariantTitle        string               `json:"variant_title,omitempty"`
+	Name                string               `json:"name,omitempty"`
+	SKU                 string               `json:"sku,omitempty"`
+	Vendor              string               `json:"vendor,omitempty"`
+	Quantity            int                  `json:"quantity,omitempty"`
+	FulfillableQuantity int                  `json:"fulfillable_quantity,omitempty"`
+	FulfillmentService  string               `json:"fulfillment_service,omitempty"`
+	FulfillmentStatus   string               `json:"fulfillment_status,omitempty"`
+	Grams               int                  `json:"grams,omitempty"`
+	Price               *Money               `json:"price,omitempty"`
+	PriceSet            *MoneySet            `json:"price_set,omitempty"`
+	TotalDiscount       *Money               `json:"total_discount,omitempty"`
+	TotalDiscountSet    *MoneySet            `json:"total_discount_set,omitempty"`
+	Taxable             bool                 `json:"taxable"`
+	RequiresShipping    bool                 `json:"requires_shipping"`
+	GiftCard            bool                 `json:"gift_card"`
+	Properties          []LineItemProperty   `json:"properties,omitempty"`
+	TaxLines            []TaxLine            `json:"tax_lines,omitempty"`
+	DiscountAllocations []DiscountAllocation `json:"discount_allocations,omitempty"`
+}
+
+// ShippingLineID is an ID of a shipping line.
+type ShippingLineID int
+
+// ShippingLine represents a shipping method of an order.
+type ShippingLine struct {
+	ID                  ShippingLineID       `json:"id,omitempty"`
+	Title               string               `json:"title,omitempty"`
+	Code                string               `json:"code,omitempty"`
+	Source              string               `json:"source,omitempty"`
+	CarrierIdentifier   string               `json:"carrier_identifier,omitempty"`
+	Price               *Money               `json:"price,omitempty"`
+	PriceSet            *MoneySet            `json:"price_set,omitempty"`
+	DiscountedPrice     *Money               `json:"discounted_price,omitempty"`
+	DiscountedPriceSet  *MoneySet            `json:"discounted_price_set,omitempty"`
+	TaxLines            []TaxLine            `json:"tax_lines,omitempty"`
+	DiscountAllocations []DiscountAllocation `json:"discount_allocations,omitempty"`
+}
+
+// NoteAttribute represents a custom attribute of an order.
+type NoteAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Order represents an order.
+//
+// The amounts of the order and of its lines are in the shop currency,
+// indicated by Currency. The MoneySet fields also hold them in the
+// presentment currency.
+//
+// Empty fields are omitted when updating an order, so that only the
+// specified fields are changed. Only a few fields can be updated: see
+// UpdateOrder.
+type Order struct {
+	ID                     OrderID                `json:"id,omitempty"`
+	Name                   string                 `json:"name,omitempty"`
+	Number                 int                    `json:"number,omitempty"`
+	OrderNumber            int                    `json:"order_number,omitempty"`
+	Email                  string                 `json:"email,omitempty"`
+	Phone                  string                 `json:"phone,omitempty"`
+	Note                   string                 `json:"note,omitempty"`
+	NoteAttributes         []NoteAttribute        `json:"note_attributes,omitempty"`
+	Tags                   *ProductTags           `json:"tags,omitempty"`
+	BuyerAcceptsMarketing  *bool                  `json:"buyer_accepts_marketing,omitempty"`
+	Currency               CurrencyCode           `json:"currency,omitempty"`
+	PresentmentCurrency    CurrencyCode           `json:"presentment_currency,omitempty"`
+	TotalPrice             *Money                 `json:"total_price,omitempty"`
+	TotalPriceSet          *MoneySet              `json:"total_price_set,omitempty"`
+	SubtotalPrice          *Money                 `json:"subtotal_price,omitempty"`
+	SubtotalPriceSet       *MoneySet              `json:"subtotal_price_set,omitempty"`
+	TotalTax               *Money                 `json:"total_tax,omitempty"`
+	TotalTaxSet            *MoneySet              `json:"total_tax_set,omitempty"`
+	TotalDiscounts         *Money                 `json:"total_discounts,omitempty"`
+	TotalDiscountsSet      *MoneySet              `json:"total_discounts_set,omitempty"`
+	TotalLineItemsPrice    *Money                 `json:"total_line_items_price,omitempty"`
+	TotalLineItemsPriceSet *MoneySet              `json:"total_line_items_price_set,omitempty"`
+	TotalShippingPriceSet  *MoneySet              `json:"total_shipping_price_set,omitempty"`
+	TotalWeight            int                    `json:"total_weight,omitempty"`
+	TaxesIncluded          bool                   `json:"taxes_included,omitempty"`
+	FinancialStatus        OrderFinancialStatus   `json:"financial_status,omitempty"`
+	FulfillmentStatus      OrderFulfillmentStatus `json:"fulfillment_status,omitempty"`
+	CancelReason           OrderCancelReason      `json:"cancel_reason,omitempty"`
+	SourceName             string                 `json:"source_name,omitempty"`
+	Test                   bool                   `json:"test,omitempty"`
+	LineItems              []LineItem             `json:"line_items,omitempty"`
+	ShippingLines          []ShippingLine         `json:"shipping_lines,omitempty"`
+	TaxLines               []TaxLine              `json:"tax_lines,omitempty"`
+	DiscountApplications   []DiscountApplication  `json:"discount_applications,omitempty"`
+	DiscountCodes          []DiscountCode         `json:"discount_codes,omitempty"`
+	BillingAddress         *Address               `json:"billing_address,omitempty"`
+	ShippingAddress        *Address               `json:"shipping_address,omitempty"`
+	Customer               *Customer              `json:"customer,omitempty"`
+	AdminGraphQLAPIID      string                 `json:"admin_graphql_api_id,omitempty"`
+	CancelledAt            *time.Time             `json:"cancelled_at,omitempty"`
+	ClosedAt               *time.Time             `json:"closed_at,omitempty"`
+	ProcessedAt            *time.Time             `json:"processed_at,omitempty"`
+	CreatedAt              *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time             `json:"updated_at,omitempty"`
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// The amounts are given the currency of the order.
+func (o *Order) UnmarshalJSON(b []byte) error {
+	type order Order
+
+	if err := json.Unmarshal(b, (*order)(o)); err != nil {
+		return err
+	}
+
+	setMoneyCurrency(reflect.ValueOf(o), o.Currency)
+
+	return nil
+}
+
+type orderEnvelope struct {
+	Order Order `json:"order"`
+}
+
+// OrderFilter represents filters for listing or counting orders.
+//
+// To list orders after a specific ID, use Pagination.SinceID.
+type OrderFilter struct {
+	// IDs restricts the results to the specified orders.
+	//
+	// It is ignored when counting.
+	IDs               []OrderID
+	Status            OrderStatus
+	FinancialStatus   OrderFinancialStatus
+	FulfillmentStatus OrderFulfillmentStatusFilter
+	CreatedAtMin      time.Time
+	CreatedAtMax      time.Time
+	UpdatedAtMin      time.Time
+	UpdatedAtMax      time.Time
+	ProcessedAtMin    time.Time
+	ProcessedAtMax    time.Time
+}
+
+func (f *OrderFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+
+		for i, id := range f.IDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("ids", strings.Join(ids, ","))
+	}
+
+	setString(values, "status", string(f.Status))
+	setString(values, "financial_status", string(f.FinancialStatus))
+	setString(values, "fulfillment_status", string(f.FulfillmentStatus))
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "processed_at_min", f.ProcessedAtMin)
+	setTime(values, "processed_at_max", f.ProcessedAtMax)
+}
+
+// GetAllOrders retrieves a list of all orders matching the filter.
+func (c *AdminClient) GetAllOrders(ctx context.Context, filter *OrderFilter, fields SelectedFields) ([]Order, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Order
+
+	for page := 1; pagination != nil; page++ {
+		orders, links, err := c.GetOrders(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, orders...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetOrders retrieves a list of orders matching the filter.
+//
+// Unless specified otherwise in the filter, Shopify only returns open
+// orders.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllOrders.
+func (c *AdminClient) GetOrders(ctx context.Context, filter *OrderFilter, pagination *Pagination, fields SelectedFields) ([]Order, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Orders []Order `json:"orders"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "orders.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Orders, parsePageLinks(header), nil
+}
+
+// GetOrdersCount retrieves the count of all orders matching the filter.
+func (c *AdminClient) GetOrdersCount(ctx context.Context, filter *OrderFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "orders/count.json", values)
+}
+
+// GetOrder fetches an order by ID.
+//
+// If no such order exists, a nil order and no error is returned.
+func (c *AdminClient) GetOrder(ctx context.Context, id OrderID, fields SelectedFields) (*Order, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &orderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Order, nil
+}
+
+// UpdateOrder updates an order.
+//
+// Only the non-empty fields of the order are updated. Shopify only allows
+// updating the note, note attributes, email, phone, buyer marketing consent,
+// shipping address and tags of an order.
+func (c *AdminClient) UpdateOrder(ctx context.Context, order Order) (*Order, error) {
+	body := &orderEnvelope{Order: order}
+	result := &orderEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("orders/%d.json", order.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Order, nil
+}
+
+// CloseOrder closes an order.
+func (c *AdminClient) CloseOrder(ctx context.Context, id OrderID) (*Order, error) {
+	result := &orderEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("orders/%d/close.json", id), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Order, nil
+}
+
+// ReopenOrder re-opens a closed order.
+func (c *AdminClient) ReopenOrder(ctx context.Context, id OrderID) (*Order, error) {
+	result := &orderEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("orders/%d/open.json", id), nil, struct{}{}, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Order, nil
+}
+
+// OrderCancelOptions represents the options of an order cancellation.
+type OrderCancelOptions struct {
+	// Reason is the reason of the cancellation.
+	Reason OrderCancelReason `json:"reason,omitempty"`
+
+	// Email indicates whether to send a notification to the customer.
+	Email bool `json:"email,omitempty"`
+
+	// Restock indicates whether to restock the items of the order.
+	Restock bool `json:"restock,omitempty"`
+
+	// Amount is the amount to refund. Currency defaults to its currency.
+	Amount   *Money       `json:"amount,omitempty"`
+	Currency CurrencyCode `json:"currency,omitempty"`
+}
+
+// CancelOrder cancels an order.
+//
+// Options may be nil, in which case Shopify defaults apply.
+func (c *AdminClient) CancelOrder(ctx context.Context, id OrderID, options *OrderCancelOptions) (*Order, error) {
+	if options == nil {
+		options = &OrderCancelOptions{}
+	}
+
+	if options.Amount != nil && options.Currency == "" {
+		o := *options
+		o.Currency = o.Amount.CurrencyCode
+		options = &o
+	}
+
+	result := &orderEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("orders/%d/cancel.json", id), nil, options, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Order, nil
+}
@@ -0,0 +1,86 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOrderFilterInjectInto(t *testing.T) {
+	values := url.Values{}
+	filter := &OrderFilter{
+		IDs:               []OrderID{1, 2},
+		Status:            OrderStatusAny,
+		FinancialStatus:   OrderFinancialStatusPaid,
+		FulfillmentStatus: OrderFulfillmentStatusFilterUnshipped,
+		CreatedAtMin:      time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	filter.injectInto(values)
+
+	expected := "created_at_min=2019-01-02T03%3A04%3A05Z&financial_status=paid&fulfillment_status=unshipped&ids=1%2C2&status=any"
+
+	if values.Encode() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, values.Encode())
+	}
+}
+
+func TestGetOrder(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"order":{"id":450789469,"financial_status":"partially_refunded","fulfillment_status":null,"line_items":[{"id":466157049,"variant_id":39072856,"quantity":1,"price":"199.00","tax_lines":[{"title":"State Tax","price":"3.98","rate":0.06}]}],"customer":{"id":207119551,"email":"bob.norman@mail.example.com"},"shipping_address":{"city":"Ottawa","country_code":"CA"}}}`))
+	}))
+
+	order, err := client.GetOrder(ctx, 450789469, nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if order.FinancialStatus != OrderFinancialStatusPartiallyRefunded {
+		t.Errorf("expected `%s` but got `%s`", OrderFinancialStatusPartiallyRefunded, order.FinancialStatus)
+	}
+
+	if order.FulfillmentStatus != "" {
+		t.Errorf("expected no fulfillment status but got `%s`", order.FulfillmentStatus)
+	}
+
+	if len(order.LineItems) != 1 || order.LineItems[0].TaxLines[0].Rate != 0.06 {
+		t.Errorf("unexpected line items: %#v", order.LineItems)
+	}
+
+	if order.Customer == nil || order.Customer.ID != 207119551 {
+		t.Errorf("unexpected customer: %#v", order.Customer)
+	}
+
+	if order.ShippingAddress == nil || order.ShippingAddress.City != "Ottawa" {
+		t.Errorf("unexpected shipping address: %#v", order.ShippingAddress)
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/orders/1/cancel.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"reason":"customer","email":true}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"order":{"id":1,"cancel_reason":"customer"}}`))
+	}))
+
+	order, err := client.CancelOrder(ctx, 1, &OrderCancelOptions{Reason: OrderCancelReasonCustomer, Email: true})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if order.CancelReason != OrderCancelReasonCustomer {
+		t.Errorf("expected `%s` but got `%s`", OrderCancelReasonCustomer, order.CancelReason)
+	}
+}
@@ -0,0 +1,174 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PageID is an ID of a page.
+type PageID int
+
+// Page represents a static page of the online store.
+//
+// Empty fields are omitted when creating or updating a page, so that only
+// the specified fields are changed.
+type Page struct {
+	ID                PageID     `json:"id,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	Handle            string     `json:"handle,omitempty"`
+	BodyHTML          string     `json:"body_html,omitempty"`
+	Author            string     `json:"author,omitempty"`
+	TemplateSuffix    string     `json:"template_suffix,omitempty"`
+	AdminGraphQLAPIID string     `json:"admin_graphql_api_id,omitempty"`
+	PublishedAt       *time.Time `json:"published_at,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+
+	// Published can be set when creating or updating a page, to publish or
+	// hide it. It is never returned: see PublishedAt.
+	Published *bool `json:"published,omitempty"`
+}
+
+type pageEnvelope struct {
+	Page Page `json:"page"`
+}
+
+// PageFilter represents filters to apply when listing pages.
+type PageFilter struct {
+	Title           string
+	Handle          string
+	PublishedStatus string
+	CreatedAtMin    time.Time
+	CreatedAtMax    time.Time
+	UpdatedAtMin    time.Time
+	UpdatedAtMax    time.Time
+	PublishedAtMin  time.Time
+	PublishedAtMax  time.Time
+}
+
+func (f *PageFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	setString(values, "title", f.Title)
+	setString(values, "handle", f.Handle)
+	setString(values, "published_status", f.PublishedStatus)
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "published_at_min", f.PublishedAtMin)
+	setTime(values, "published_at_max", f.PublishedAtMax)
+}
+
+// GetAllPages retrieves a list of all pages matching the filter.
+func (c *AdminClient) GetAllPages(ctx context.Context, filter *PageFilter, fields SelectedFields) ([]Page, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Page
+
+	for page := 1; pagination != nil; page++ {
+		pages, links, err := c.GetPages(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, pages...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetPages retrieves a list of pages matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllPages.
+func (c *AdminClient) GetPages(ctx context.Context, filter *PageFilter, pagination *Pagination, fields SelectedFields) ([]Page, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Pages []Page `json:"pages"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "pages.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Pages, parsePageLinks(header), nil
+}
+
+// GetPagesCount retrieves the count of all pages matching the filter.
+func (c *AdminClient) GetPagesCount(ctx context.Context, filter *PageFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, "pages/count.json", values)
+}
+
+// GetPage fetches a page by ID.
+//
+// If no such page exists, a nil page and no error is returned.
+func (c *AdminClient) GetPage(ctx context.Context, id PageID, fields SelectedFields) (*Page, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &pageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("pages/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Page, nil
+}
+
+// CreatePage creates a page.
+func (c *AdminClient) CreatePage(ctx context.Context, page Page) (*Page, error) {
+	body := &pageEnvelope{Page: page}
+	result := &pageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "pages.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Page, nil
+}
+
+// UpdatePage updates a page.
+func (c *AdminClient) UpdatePage(ctx context.Context, page Page) (*Page, error) {
+	body := &pageEnvelope{Page: page}
+	result := &pageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("pages/%d.json", page.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Page, nil
+}
+
+// DeletePage deletes a page.
+func (c *AdminClient) DeletePage(ctx context.Context, id PageID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("pages/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
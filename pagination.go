@@ -0,0 +1,118 @@
+package shopify
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// DefaultLimit is the default limit, as specified by Shopify.
+	DefaultLimit = 50
+
+	// MaxLimit is the maximum allowed limit, as specified by Shopify.
+	MaxLimit = 250
+)
+
+// PageInfo represents an opaque pagination cursor, as returned by Shopify in
+// the Link header of list calls.
+type PageInfo string
+
+// Pagination represents pagination options.
+type Pagination struct {
+	Limit   int
+	SinceID int
+
+	// PageInfo is the cursor of the page to fetch.
+	//
+	// When a cursor is specified, Shopify forbids any other parameter than
+	// the limit and the selected fields: SinceID and any filter are then
+	// ignored.
+	PageInfo PageInfo
+}
+
+// hasCursor returns true if the pagination refers to a cursor.
+func (o *Pagination) hasCursor() bool {
+	return o != nil && o.PageInfo != ""
+}
+
+func (o *Pagination) injectInto(values url.Values) {
+	if o == nil {
+		return
+	}
+
+	if o.Limit != 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+
+	if o.PageInfo != "" {
+		values.Set("page_info", string(o.PageInfo))
+		return
+	}
+
+	if o.SinceID != 0 {
+		values.Set("since_id", strconv.Itoa(o.SinceID))
+	}
+}
+
+// PageLinks represents the links to the pages adjacent to a page, as returned
+// by list calls.
+type PageLinks struct {
+	// Next is the pagination to use to fetch the next page, if there is one.
+	Next *Pagination
+
+	// Previous is the pagination to use to fetch the previous page, if there
+	// is one.
+	Previous *Pagination
+}
+
+var linkRegexp = regexp.MustCompile(`<([^>]*)>\s*;\s*rel="?([^",;]*)"?`)
+
+// parsePageLinks parses the Link header of a response.
+//
+// The result is never nil.
+func parsePageLinks(header http.Header) *PageLinks {
+	links := &PageLinks{}
+
+	for _, value := range header["Link"] {
+		for _, match := range linkRegexp.FindAllStringSubmatch(value, -1) {
+			pagination := parsePaginationURL(match[1])
+
+			if pagination == nil {
+				continue
+			}
+
+			switch match[2] {
+			case "next":
+				links.Next = pagination
+			case "previous", "prev":
+				links.Previous = pagination
+			}
+		}
+	}
+
+	return links
+}
+
+func parsePaginationURL(s string) *Pagination {
+	u, err := url.Parse(s)
+
+	if err != nil {
+		return nil
+	}
+
+	values := u.Query()
+	pageInfo := values.Get("page_info")
+
+	if pageInfo == "" {
+		return nil
+	}
+
+	limit, _ := strconv.Atoi(values.Get("limit"))
+
+	return &Pagination{
+		Limit:    limit,
+		PageInfo: PageInfo(pageInfo),
+	}
+}
@@ -0,0 +1,101 @@
+package shopify
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParsePageLinks(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Link     []string
+		Expected *PageLinks
+	}{
+		{
+			Name:     "none",
+			Expected: &PageLinks{},
+		},
+		{
+			Name: "next",
+			Link: []string{
+				`<https://shop.myshopify.com/admin/api/2019-07/products.json?limit=3&page_info=abc>; rel="next"`,
+			},
+			Expected: &PageLinks{
+				Next: &Pagination{Limit: 3, PageInfo: "abc"},
+			},
+		},
+		{
+			Name: "both",
+			Link: []string{
+				`<https://shop.myshopify.com/admin/api/2019-07/products.json?page_info=def&limit=3>; rel="previous", <https://shop.myshopify.com/admin/api/2019-07/products.json?page_info=ghi&limit=3>; rel="next"`,
+			},
+			Expected: &PageLinks{
+				Next:     &Pagination{Limit: 3, PageInfo: "ghi"},
+				Previous: &Pagination{Limit: 3, PageInfo: "def"},
+			},
+		},
+		{
+			Name: "no cursor",
+			Link: []string{
+				`<https://shop.myshopify.com/admin/api/2019-07/products.json?limit=3>; rel="next"`,
+			},
+			Expected: &PageLinks{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			header := http.Header{"Link": testCase.Link}
+			value := parsePageLinks(header)
+
+			if !reflect.DeepEqual(value, testCase.Expected) {
+				t.Errorf("expected: %#v\ngot: %#v", testCase.Expected, value)
+			}
+		})
+	}
+}
+
+func TestPaginationInjectInto(t *testing.T) {
+	values := url.Values{}
+	pagination := &Pagination{Limit: 10, SinceID: 5, PageInfo: "abc"}
+	pagination.injectInto(values)
+
+	expected := "limit=10&page_info=abc"
+
+	if values.Encode() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, values.Encode())
+	}
+}
+
+func TestGetAllScriptTags(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("page_info") {
+		case "":
+			w.Header().Set("Link", `<https://`+req.Host+req.URL.Path+`?limit=250&page_info=p2>; rel="next"`)
+			w.Write([]byte(`{"script_tags":[{"id":1},{"id":2}]}`))
+		case "p2":
+			w.Header().Set("Link", `<https://`+req.Host+req.URL.Path+`?limit=250&page_info=p1>; rel="previous"`)
+			w.Write([]byte(`{"script_tags":[{"id":3}]}`))
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+
+	scriptTags, err := client.GetAllScriptTags(ctx, nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(scriptTags) != 3 {
+		t.Fatalf("expected %d script tags but got %d", 3, len(scriptTags))
+	}
+
+	for i, scriptTag := range scriptTags {
+		if scriptTag.ID != ScriptTagID(i+1) {
+			t.Errorf("expected %d but got %d", i+1, scriptTag.ID)
+		}
+	}
+}
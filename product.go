@@ -1,11 +1,27 @@
 package shopify
 
-import "strings"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // ProductTag represents a product tag.
 type ProductTag string
 
 // ProductTags represents a list of product tags.
+//
+// Product tags are represented in JSON as a comma-separated string. The tags
+// of orders, draft orders and customers use the same representation.
+//
+// The resources reference their tags with a pointer, so that they can be
+// cleared by an update: a nil pointer leaves the tags unchanged, while an
+// empty list removes all of them.
 type ProductTags []ProductTag
 
 func (t ProductTags) String() string {
@@ -36,3 +52,245 @@ func ParseProductTags(s string) (ProductTags, error) {
 
 	return result, nil
 }
+
+// MarshalJSON implements JSON marshalling.
+func (t ProductTags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+func (t *ProductTags) UnmarshalJSON(b []byte) (err error) {
+	var str string
+
+	if err = json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	*t, err = ParseProductTags(str)
+
+	return
+}
+
+// ProductID is an ID of a product.
+type ProductID int
+
+// ProductStatus represents the status of a product.
+type ProductStatus string
+
+const (
+	// ProductStatusActive indicates that the product is ready to sell.
+	ProductStatusActive ProductStatus = "active"
+	// ProductStatusArchived indicates that the product is no longer being
+	// sold.
+	ProductStatusArchived ProductStatus = "archived"
+	// ProductStatusDraft indicates that the product is not ready to sell.
+	ProductStatusDraft ProductStatus = "draft"
+)
+
+// ProductOptionID is an ID of a product option.
+type ProductOptionID int
+
+// ProductOption represents a product option, such as a size or a color.
+type ProductOption struct {
+	ID        ProductOptionID `json:"id,omitempty"`
+	ProductID ProductID       `json:"product_id,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Position  int             `json:"position,omitempty"`
+	Values    []string        `json:"values,omitempty"`
+}
+
+// Product represents a product.
+//
+// Empty fields are omitted when creating or updating a product, so that only
+// the specified fields are changed.
+type Product struct {
+	ID                ProductID        `json:"id,omitempty"`
+	Title             string           `json:"title,omitempty"`
+	BodyHTML          string           `json:"body_html,omitempty"`
+	Vendor            string           `json:"vendor,omitempty"`
+	ProductType       string           `json:"product_type,omitempty"`
+	Handle            string           `json:"handle,omitempty"`
+	Status            ProductStatus    `json:"status,omitempty"`
+	Tags              *ProductTags     `json:"tags,omitempty"`
+	TemplateSuffix    string           `json:"template_suffix,omitempty"`
+	PublishedScope    string           `json:"published_scope,omitempty"`
+	Variants          []ProductVariant `json:"variants,omitempty"`
+	Options           []ProductOption  `json:"options,omitempty"`
+	Images            []ProductImage   `json:"images,omitempty"`
+	Image             *ProductImage    `json:"image,omitempty"`
+	AdminGraphQLAPIID string           `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt         *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time       `json:"updated_at,omitempty"`
+	PublishedAt       *time.Time       `json:"published_at,omitempty"`
+}
+
+type productEnvelope struct {
+	Product Product `json:"product"`
+}
+
+// ProductFilter represents filters for listing or counting products.
+type ProductFilter struct {
+	// IDs restricts the results to the specified products.
+	//
+	// It is ignored when counting.
+	IDs             []ProductID
+	Title           string
+	Vendor          string
+	Handle          string
+	ProductType     string
+	CollectionID    CollectionID
+	Status          ProductStatus
+	PublishedStatus string
+	CreatedAtMin    time.Time
+	CreatedAtMax    time.Time
+	UpdatedAtMin    time.Time
+	UpdatedAtMax    time.Time
+	PublishedAtMin  time.Time
+	PublishedAtMax  time.Time
+}
+
+func (f *ProductFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+
+		for i, id := range f.IDs {
+			ids[i] = strconv.Itoa(int(id))
+		}
+
+		values.Set("ids", strings.Join(ids, ","))
+	}
+
+	setString(values, "title", f.Title)
+	setString(values, "vendor", f.Vendor)
+	setString(values, "handle", f.Handle)
+	setString(values, "product_type", f.ProductType)
+	setString(values, "status", string(f.Status))
+	setString(values, "published_status", f.PublishedStatus)
+
+	if f.CollectionID != 0 {
+		values.Set("collection_id", strconv.Itoa(int(f.CollectionID)))
+	}
+
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+	setTime(values, "published_at_min", f.PublishedAtMin)
+	setTime(values, "published_at_max", f.PublishedAtMax)
+}
+
+// GetAllProducts retrieves a list of all products matching the filter.
+func (c *AdminClient) GetAllProducts(ctx context.Context, filter *ProductFilter, fields SelectedFields) ([]Product, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Product
+
+	for page := 1; pagination != nil; page++ {
+		products, links, err := c.GetProducts(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, products...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetProducts retrieves a list of products matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllProducts.
+func (c *AdminClient) GetProducts(ctx context.Context, filter *ProductFilter, pagination *Pagination, fields SelectedFields) ([]Product, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Products []Product `json:"products"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "products.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Products, parsePageLinks(header), nil
+}
+
+// GetProductsCount retrieves the count of all products matching the filter.
+func (c *AdminClient) GetProductsCount(ctx context.Context, filter *ProductFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "products/count.json", values)
+}
+
+// GetProduct fetches a product by ID.
+//
+// If no such product exists, a nil product and no error is returned.
+func (c *AdminClient) GetProduct(ctx context.Context, id ProductID, fields SelectedFields) (*Product, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &productEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("products/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Product, nil
+}
+
+// CreateProduct creates a product, along with its variants, options and
+// images.
+func (c *AdminClient) CreateProduct(ctx context.Context, product Product) (*Product, error) {
+	body := &productEnvelope{Product: product}
+	result := &productEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "products.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Product, nil
+}
+
+// UpdateProduct updates a product.
+//
+// Only the non-empty fields of the product are updated.
+func (c *AdminClient) UpdateProduct(ctx context.Context, product Product) (*Product, error) {
+	body := &productEnvelope{Product: product}
+	result := &productEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("products/%d.json", product.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Product, nil
+}
+
+// DeleteProduct deletes a product.
+func (c *AdminClient) DeleteProduct(ctx context.Context, id ProductID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("products/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
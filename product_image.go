@@ -0,0 +1,175 @@
+package shopify
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ProductImageID is an ID of a product image.
+type ProductImageID int
+
+// ProductImage represents a product image.
+//
+// When creating an image, either Src must be the URL of the image to fetch or
+// Attachment must contain the base64-encoded image. See
+// CreateProductImageFromReader.
+type ProductImage struct {
+	ID                ProductImageID     `json:"id,omitempty"`
+	ProductID         ProductID          `json:"product_id,omitempty"`
+	Position          int                `json:"position,omitempty"`
+	Src               string             `json:"src,omitempty"`
+	Alt               string             `json:"alt,omitempty"`
+	Width             int                `json:"width,omitempty"`
+	Height            int                `json:"height,omitempty"`
+	VariantIDs        []ProductVariantID `json:"variant_ids,omitempty"`
+	Attachment        string             `json:"attachment,omitempty"`
+	Filename          string             `json:"filename,omitempty"`
+	AdminGraphQLAPIID string             `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt         *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time         `json:"updated_at,omitempty"`
+}
+
+type productImageEnvelope struct {
+	Image ProductImage `json:"image"`
+}
+
+// GetProductImages retrieves the list of all the images of a product.
+func (c *AdminClient) GetProductImages(ctx context.Context, productID ProductID, fields SelectedFields) ([]ProductImage, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &struct {
+		Images []ProductImage `json:"images"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("products/%d/images.json", productID), values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Images, nil
+}
+
+// GetProductImagesCount retrieves the count of the images of a product.
+func (c *AdminClient) GetProductImagesCount(ctx context.Context, productID ProductID) (int, error) {
+	return c.getCount(ctx, fmt.Sprintf("products/%d/images/count.json", productID), nil)
+}
+
+// GetProductImage fetches a product image by ID.
+//
+// If no such image exists, a nil image and no error is returned.
+func (c *AdminClient) GetProductImage(ctx context.Context, productID ProductID, id ProductImageID, fields SelectedFields) (*ProductImage, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &productImageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("products/%d/images/%d.json", productID, id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Image, nil
+}
+
+// CreateProductImage creates an image for a product.
+//
+// The image is typically fetched by Shopify from its Src URL.
+func (c *AdminClient) CreateProductImage(ctx context.Context, productID ProductID, image ProductImage) (*ProductImage, error) {
+	body := &productImageEnvelope{Image: image}
+	result := &productImageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("products/%d/images.json", productID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Image, nil
+}
+
+// CreateProductImageFromReader creates an image for a product, uploading its
+// content from the specified reader.
+//
+// The filename is used by Shopify to name the image file.
+func (c *AdminClient) CreateProductImageFromReader(ctx context.Context, productID ProductID, image ProductImage, filename string, r io.Reader) (*ProductImage, error) {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %s", err)
+	}
+
+	image.Src = ""
+	image.Attachment = base64.StdEncoding.EncodeToString(data)
+	image.Filename = filename
+
+	return c.CreateProductImage(ctx, productID, image)
+}
+
+// UpdateProductImage updates a product image.
+//
+// Only the non-empty fields of the image are updated. To remove all the
+// variants of an image, use SetProductImageVariants.
+func (c *AdminClient) UpdateProductImage(ctx context.Context, image ProductImage) (*ProductImage, error) {
+	body := &productImageEnvelope{Image: image}
+	result := &productImageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("products/%d/images/%d.json", image.ProductID, image.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Image, nil
+}
+
+// SetProductImagePosition moves a product image to the specified position,
+// starting at 1.
+//
+// Shopify shifts the other images accordingly.
+func (c *AdminClient) SetProductImagePosition(ctx context.Context, productID ProductID, id ProductImageID, position int) (*ProductImage, error) {
+	return c.UpdateProductImage(ctx, ProductImage{
+		ID:        id,
+		ProductID: productID,
+		Position:  position,
+	})
+}
+
+// SetProductImageVariants associates a product image to the specified
+// variants, replacing any previous association.
+//
+// An empty list of variants removes all associations.
+func (c *AdminClient) SetProductImageVariants(ctx context.Context, productID ProductID, id ProductImageID, variantIDs []ProductVariantID) (*ProductImage, error) {
+	if variantIDs == nil {
+		variantIDs = []ProductVariantID{}
+	}
+
+	// The variant IDs cannot be omitted here, even if empty.
+	body := &struct {
+		Image struct {
+			ID         ProductImageID     `json:"id"`
+			VariantIDs []ProductVariantID `json:"variant_ids"`
+		} `json:"image"`
+	}{}
+	body.Image.ID = id
+	body.Image.VariantIDs = variantIDs
+
+	result := &productImageEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("products/%d/images/%d.json", productID, id), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Image, nil
+}
+
+// DeleteProductImage deletes a product image.
+func (c *AdminClient) DeleteProductImage(ctx context.Context, productID ProductID, id ProductImageID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("products/%d/images/%d.json", productID, id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,52 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateProductImageFromReader(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/products/1/images.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"image":{"alt":"A picture","variant_ids":[2],"attachment":"aGVsbG8=","filename":"a.png"}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"image":{"id":3,"product_id":1,"variant_ids":[2]}}`))
+	}))
+
+	image, err := client.CreateProductImageFromReader(ctx, 1, ProductImage{Alt: "A picture", VariantIDs: []ProductVariantID{2}}, "a.png", strings.NewReader("hello"))
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if image.ID != 3 {
+		t.Errorf("expected %d but got %d", 3, image.ID)
+	}
+}
+
+func TestSetProductImageVariants(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"image":{"id":3,"variant_ids":[]}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"image":{"id":3,"product_id":1,"variant_ids":[]}}`))
+	}))
+
+	if _, err := client.SetProductImageVariants(ctx, 1, 3, nil); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+}
@@ -0,0 +1,147 @@
+package shopify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProductTagsJSON(t *testing.T) {
+	value := struct {
+		Tags ProductTags `json:"tags"`
+	}{}
+
+	data := []byte(`{"tags": "a, b,,c"}`)
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	ref := ProductTags{"a", "b", "c"}
+
+	if !reflect.DeepEqual(value.Tags, ref) {
+		t.Errorf("values differ (%#v != %#v)", value.Tags, ref)
+	}
+
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	expected := `{"tags":"a,b,c"}`
+
+	if string(data) != expected {
+		t.Errorf("expected: %s\ngot: %s", expected, string(data))
+	}
+}
+
+func TestProductFilterInjectInto(t *testing.T) {
+	values := url.Values{}
+	filter := &ProductFilter{
+		IDs:          []ProductID{1, 2},
+		Vendor:       "Acme",
+		Status:       ProductStatusActive,
+		CollectionID: 3,
+		CreatedAtMin: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	filter.injectInto(values)
+
+	expected := "collection_id=3&created_at_min=2019-01-02T03%3A04%3A05Z&ids=1%2C2&status=active&vendor=Acme"
+
+	if values.Encode() != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, values.Encode())
+	}
+}
+
+func TestGetProductsWithCursor(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		expected := "limit=10&page_info=abc"
+
+		if req.URL.RawQuery != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"products":[{"id":1,"tags":"a,b"}]}`))
+	}))
+
+	products, links, err := client.GetProducts(ctx, &ProductFilter{Vendor: "Acme"}, &Pagination{Limit: 10, PageInfo: "abc"}, nil)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if links.Next != nil || links.Previous != nil {
+		t.Errorf("expected no links but got: %#v", links)
+	}
+
+	if len(products) != 1 || products[0].Tags == nil || len(*products[0].Tags) != 2 {
+		t.Errorf("unexpected products: %#v", products)
+	}
+}
+
+func TestCreateProduct(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("expected `%s` but got `%s`", http.MethodPost, req.Method)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"product":{"title":"Shoes","status":"draft","tags":"red,leather"}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"product":{"id":42,"title":"Shoes","status":"draft","tags":"red, leather"}}`))
+	}))
+
+	product, err := client.CreateProduct(ctx, Product{
+		Title:  "Shoes",
+		Status: ProductStatusDraft,
+		Tags:   &ProductTags{"red", "leather"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if product.ID != 42 {
+		t.Errorf("expected %d but got %d", 42, product.ID)
+	}
+
+	if !reflect.DeepEqual(product.Tags, &ProductTags{"red", "leather"}) {
+		t.Errorf("unexpected tags: %#v", product.Tags)
+	}
+}
+
+func TestUpdateProductClearTags(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"product":{"id":42,"tags":""}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"product":{"id":42,"tags":""}}`))
+	}))
+
+	product, err := client.UpdateProduct(ctx, Product{
+		ID:   42,
+		Tags: &ProductTags{},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if product.Tags == nil || len(*product.Tags) != 0 {
+		t.Errorf("unexpected tags: %#v", product.Tags)
+	}
+}
@@ -0,0 +1,180 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ProductVariantID is an ID of a product variant.
+type ProductVariantID int
+
+// ProductVariantPresentmentPrice represents the prices of a product variant
+// in a presentment currency.
+type ProductVariantPresentmentPrice struct {
+	Price          Money  `json:"price"`
+	CompareAtPrice *Money `json:"compare_at_price,omitempty"`
+}
+
+// MarshalJSON implements JSON marshalling.
+//
+// Unlike the other amounts of the variant, the presentment prices are
+// marshalled with their currency.
+func (p ProductVariantPresentmentPrice) MarshalJSON() ([]byte, error) {
+	payload := struct {
+		Price          moneyObject  `json:"price"`
+		CompareAtPrice *moneyObject `json:"compare_at_price,omitempty"`
+	}{
+		Price: moneyObject(p.Price),
+	}
+
+	if p.CompareAtPrice != nil {
+		compareAtPrice := moneyObject(*p.CompareAtPrice)
+		payload.CompareAtPrice = &compareAtPrice
+	}
+
+	return json.Marshal(payload)
+}
+
+// ProductVariant represents a product variant.
+//
+// Price and CompareAtPrice are in the shop currency, which Shopify does not
+// return with the variant: their currency is empty. PresentmentPrices holds
+// the prices in each enabled currency.
+type ProductVariant struct {
+	ID                  ProductVariantID                 `json:"id,omitempty"`
+	ProductID           ProductID                        `json:"product_id,omitempty"`
+	Title               string                           `json:"title,omitempty"`
+	Price               *Money                           `json:"price,omitempty"`
+	CompareAtPrice      *Money                           `json:"compare_at_price,omitempty"`
+	PresentmentPrices   []ProductVariantPresentmentPrice `json:"presentment_prices,omitempty"`
+	SKU                 string                           `json:"sku,omitempty"`
+	Barcode             string                           `json:"barcode,omitempty"`
+	Position            int                              `json:"position,omitempty"`
+	InventoryPolicy     string                           `json:"inventory_policy,omitempty"`
+	FulfillmentService  string                           `json:"fulfillment_service,omitempty"`
+	InventoryManagement string                           `json:"inventory_management,omitempty"`
+	InventoryItemID     InventoryItemID                  `json:"inventory_item_id,omitempty"`
+	InventoryQuantity   int                              `json:"inventory_quantity,omitempty"`
+	Option1             string                           `json:"option1,omitempty"`
+	Option2             string                           `json:"option2,omitempty"`
+	Option3             string                           `json:"option3,omitempty"`
+	Taxable             *bool                            `json:"taxable,omitempty"`
+	RequiresShipping    *bool                            `json:"requires_shipping,omitempty"`
+	Grams               int                              `json:"grams,omitempty"`
+	Weight              float64                          `json:"weight,omitempty"`
+	WeightUnit          string                           `json:"weight_unit,omitempty"`
+	ImageID             *ProductImageID                  `json:"image_id,omitempty"`
+	AdminGraphQLAPIID   string                           `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt           *time.Time                       `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time                       `json:"updated_at,omitempty"`
+}
+
+type productVariantEnvelope struct {
+	Variant ProductVariant `json:"variant"`
+}
+
+// GetAllProductVariants retrieves a list of all the variants of a product.
+func (c *AdminClient) GetAllProductVariants(ctx context.Context, productID ProductID, fields SelectedFields) ([]ProductVariant, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []ProductVariant
+
+	for page := 1; pagination != nil; page++ {
+		variants, links, err := c.GetProductVariants(ctx, productID, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, variants...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetProductVariants retrieves a list of the variants of a product.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllProductVariants.
+func (c *AdminClient) GetProductVariants(ctx context.Context, productID ProductID, pagination *Pagination, fields SelectedFields) ([]ProductVariant, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Variants []ProductVariant `json:"variants"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("products/%d/variants.json", productID), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Variants, parsePageLinks(header), nil
+}
+
+// GetProductVariantsCount retrieves the count of the variants of a product.
+func (c *AdminClient) GetProductVariantsCount(ctx context.Context, productID ProductID) (int, error) {
+	return c.getCount(ctx, fmt.Sprintf("products/%d/variants/count.json", productID), nil)
+}
+
+// GetProductVariant fetches a product variant by ID.
+//
+// If no such variant exists, a nil variant and no error is returned.
+func (c *AdminClient) GetProductVariant(ctx context.Context, id ProductVariantID, fields SelectedFields) (*ProductVariant, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &productVariantEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("variants/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Variant, nil
+}
+
+// CreateProductVariant creates a variant for a product.
+func (c *AdminClient) CreateProductVariant(ctx context.Context, productID ProductID, variant ProductVariant) (*ProductVariant, error) {
+	body := &productVariantEnvelope{Variant: variant}
+	result := &productVariantEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("products/%d/variants.json", productID), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Variant, nil
+}
+
+// UpdateProductVariant updates a product variant.
+//
+// Only the non-empty fields of the variant are updated.
+func (c *AdminClient) UpdateProductVariant(ctx context.Context, variant ProductVariant) (*ProductVariant, error) {
+	body := &productVariantEnvelope{Variant: variant}
+	result := &productVariantEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("variants/%d.json", variant.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Variant, nil
+}
+
+// DeleteProductVariant deletes a product variant.
+func (c *AdminClient) DeleteProductVariant(ctx context.Context, productID ProductID, id ProductVariantID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("products/%d/variants/%d.json", productID, id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
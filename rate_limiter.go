@@ -0,0 +1,170 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const headerXShopifyShopAPICallLimit = "X-Shopify-Shop-Api-Call-Limit"
+
+const (
+	// DefaultBucketSize is the default size of the REST Admin API leaky
+	// bucket, as specified by Shopify.
+	//
+	// The actual size is updated from the responses, as Shopify Plus stores
+	// have a larger bucket.
+	DefaultBucketSize = 40
+
+	// DefaultLeakRate is the default number of requests per second that leak
+	// from the REST Admin API bucket, as specified by Shopify.
+	DefaultLeakRate = 2
+)
+
+// leakyBucket models the Shopify REST Admin API leaky bucket for a shop.
+//
+// It is safe for concurrent use.
+type leakyBucket struct {
+	lock      sync.Mutex
+	capacity  float64
+	level     float64
+	leakRate  float64
+	updatedAt time.Time
+}
+
+func newLeakyBucket(capacity int, leakRate float64) *leakyBucket {
+	return &leakyBucket{
+		capacity:  float64(capacity),
+		leakRate:  leakRate,
+		updatedAt: time.Now(),
+	}
+}
+
+// leak must be called with the lock held.
+func (b *leakyBucket) leak() {
+	now := time.Now()
+
+	b.level -= now.Sub(b.updatedAt).Seconds() * b.leakRate
+	b.updatedAt = now
+
+	if b.level < 0 {
+		b.level = 0
+	}
+}
+
+// reserve reserves room for one request in the bucket, blocking until there
+// is enough room or until the context expires.
+func (b *leakyBucket) reserve(ctx context.Context) error {
+	for {
+		b.lock.Lock()
+		b.leak()
+
+		if b.level+1 <= b.capacity {
+			b.level++
+			b.lock.Unlock()
+
+			return nil
+		}
+
+		delay := time.Duration((b.level + 1 - b.capacity) / b.leakRate * float64(time.Second))
+		b.lock.Unlock()
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update updates the bucket with the values reported by Shopify.
+//
+// As concurrent requests may still be in flight, the bucket level is never
+// lowered by an update: it only leaks over time.
+func (b *leakyBucket) update(used int, capacity int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.leak()
+
+	if capacity > 0 {
+		b.capacity = float64(capacity)
+	}
+
+	if float64(used) > b.level {
+		b.level = float64(used)
+	}
+}
+
+// fill marks the bucket as full, typically after Shopify rejected a request
+// for exceeding the rate limit.
+func (b *leakyBucket) fill() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.leak()
+	b.level = b.capacity
+}
+
+func (b *leakyBucket) updateFromResponse(resp *http.Response) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		b.fill()
+		return
+	}
+
+	if used, capacity, ok := parseAPICallLimit(resp.Header.Get(headerXShopifyShopAPICallLimit)); ok {
+		b.update(used, capacity)
+	}
+}
+
+// parseAPICallLimit parses an API call limit header value, such as `32/40`.
+func parseAPICallLimit(s string) (used int, capacity int, ok bool) {
+	parts := strings.SplitN(s, "/", 2)
+
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	used, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	capacity, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return used, capacity, true
+}
+
+// leakyBuckets holds the leaky buckets of all shops, so that they are shared
+// by all the AdminClient instances.
+var leakyBuckets = struct {
+	sync.Mutex
+	buckets map[Shop]*leakyBucket
+}{
+	buckets: map[Shop]*leakyBucket{},
+}
+
+func getLeakyBucket(shop Shop) *leakyBucket {
+	leakyBuckets.Lock()
+	defer leakyBuckets.Unlock()
+
+	bucket, ok := leakyBuckets.buckets[shop]
+
+	if !ok {
+		bucket = newLeakyBucket(DefaultBucketSize, DefaultLeakRate)
+		leakyBuckets.buckets[shop] = bucket
+	}
+
+	return bucket
+}
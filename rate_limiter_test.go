@@ -0,0 +1,107 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseAPICallLimit(t *testing.T) {
+	testCases := []struct {
+		S                string
+		ExpectedUsed     int
+		ExpectedCapacity int
+		ExpectedOK       bool
+	}{
+		{"32/40", 32, 40, true},
+		{" 1 / 80 ", 1, 80, true},
+		{"", 0, 0, false},
+		{"32", 0, 0, false},
+		{"a/40", 0, 0, false},
+		{"32/b", 0, 0, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.S, func(t *testing.T) {
+			used, capacity, ok := parseAPICallLimit(testCase.S)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("expected %t but got %t", testCase.ExpectedOK, ok)
+			}
+
+			if used != testCase.ExpectedUsed {
+				t.Errorf("expected %d but got %d", testCase.ExpectedUsed, used)
+			}
+
+			if capacity != testCase.ExpectedCapacity {
+				t.Errorf("expected %d but got %d", testCase.ExpectedCapacity, capacity)
+			}
+		})
+	}
+}
+
+func TestLeakyBucket(t *testing.T) {
+	ctx := context.Background()
+	bucket := newLeakyBucket(2, 20)
+
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if err := bucket.reserve(ctx); err != nil {
+			t.Fatalf("expected no error but got: %s", err)
+		}
+	}
+
+	// The third request must have waited for one request to leak.
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected the bucket to block but it only took %s", elapsed)
+	}
+}
+
+func TestLeakyBucketCancellation(t *testing.T) {
+	bucket := newLeakyBucket(1, 0.1)
+	bucket.fill()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := bucket.reserve(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected `%s` but got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestLeakyBucketUpdateFromResponse(t *testing.T) {
+	bucket := newLeakyBucket(DefaultBucketSize, DefaultLeakRate)
+
+	header := http.Header{}
+	header.Set(headerXShopifyShopAPICallLimit, "70/80")
+	bucket.updateFromResponse(newTestResponse(http.StatusOK, header, ""))
+
+	if bucket.capacity != 80 {
+		t.Errorf("expected %d but got %f", 80, bucket.capacity)
+	}
+
+	if bucket.level < 69 || bucket.level > 70 {
+		t.Errorf("expected a level of about %d but got %f", 70, bucket.level)
+	}
+
+	bucket.updateFromResponse(newTestResponse(http.StatusTooManyRequests, nil, ""))
+
+	if bucket.level < 79 {
+		t.Errorf("expected a level of about %d but got %f", 80, bucket.level)
+	}
+}
+
+func TestGetLeakyBucket(t *testing.T) {
+	a := getLeakyBucket("a.myshopify.com")
+	b := getLeakyBucket("b.myshopify.com")
+
+	if a == b {
+		t.Errorf("expected different buckets")
+	}
+
+	if getLeakyBucket("a.myshopify.com") != a {
+		t.Errorf("expected the same bucket")
+	}
+}
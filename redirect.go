@@ -0,0 +1,245 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// RedirectID is an ID of a redirect.
+type RedirectID int
+
+// Redirect represents a URL redirect of the online store.
+type Redirect struct {
+	ID RedirectID `json:"id,omitempty"`
+
+	// Path is the path to redirect, such as "/products/old".
+	Path string `json:"path,omitempty"`
+
+	// Target is the path or URL to redirect to.
+	Target string `json:"target,omitempty"`
+}
+
+type redirectEnvelope struct {
+	Redirect Redirect `json:"redirect"`
+}
+
+// RedirectFilter represents filters to apply when listing redirects.
+type RedirectFilter struct {
+	Path   string
+	Target string
+}
+
+func (f *RedirectFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	setString(values, "path", f.Path)
+	setString(values, "target", f.Target)
+}
+
+// GetAllRedirects retrieves a list of all redirects matching the filter.
+func (c *AdminClient) GetAllRedirects(ctx context.Context, filter *RedirectFilter) ([]Redirect, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Redirect
+
+	for page := 1; pagination != nil; page++ {
+		redirects, links, err := c.GetRedirects(ctx, filter, pagination)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, redirects...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetRedirects retrieves a list of redirects matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllRedirects.
+func (c *AdminClient) GetRedirects(ctx context.Context, filter *RedirectFilter, pagination *Pagination) ([]Redirect, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+
+	result := &struct {
+		Redirects []Redirect `json:"redirects"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "redirects.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Redirects, parsePageLinks(header), nil
+}
+
+// GetRedirectsCount retrieves the count of all redirects matching the
+// filter.
+func (c *AdminClient) GetRedirectsCount(ctx context.Context, filter *RedirectFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, "redirects/count.json", values)
+}
+
+// GetRedirect fetches a redirect by ID.
+//
+// If no such redirect exists, a nil redirect and no error is returned.
+func (c *AdminClient) GetRedirect(ctx context.Context, id RedirectID) (*Redirect, error) {
+	result := &redirectEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("redirects/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Redirect, nil
+}
+
+// CreateRedirect creates a redirect.
+func (c *AdminClient) CreateRedirect(ctx context.Context, redirect Redirect) (*Redirect, error) {
+	body := &redirectEnvelope{Redirect: redirect}
+	result := &redirectEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "redirects.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Redirect, nil
+}
+
+// UpdateRedirect updates a redirect.
+func (c *AdminClient) UpdateRedirect(ctx context.Context, redirect Redirect) (*Redirect, error) {
+	body := &redirectEnvelope{Redirect: redirect}
+	result := &redirectEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("redirects/%d.json", redirect.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Redirect, nil
+}
+
+// DeleteRedirect deletes a redirect.
+func (c *AdminClient) DeleteRedirect(ctx context.Context, id RedirectID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("redirects/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
+
+// RedirectConflict represents a redirect that could not be imported because
+// its path is already redirected elsewhere.
+type RedirectConflict struct {
+	// Redirect is the redirect to import.
+	Redirect Redirect
+
+	// Existing is the conflicting redirect, which either existed before the
+	// import or was created earlier in the same import.
+	Existing Redirect
+}
+
+// RedirectFailure represents a redirect that Shopify failed to create.
+type RedirectFailure struct {
+	Redirect Redirect
+	Err      error
+}
+
+// RedirectImportReport represents the result of a redirect import.
+type RedirectImportReport struct {
+	// Created are the redirects that were created.
+	Created []Redirect
+
+	// Skipped are the redirects that already existed with the same target,
+	// or that were created earlier in the same import.
+	Skipped []Redirect
+
+	// Conflicts are the redirects whose path is already redirected to a
+	// different target. They are left untouched.
+	Conflicts []RedirectConflict
+
+	// Failures are the redirects that Shopify refused to create, usually
+	// because of validation errors.
+	Failures []RedirectFailure
+}
+
+// ImportRedirects creates redirects in bulk.
+//
+// Redirects that already exist with the same target are skipped, and
+// redirects whose path is already redirected to a different target are
+// reported as conflicts rather than overwritten, including when they
+// conflict with a redirect created earlier in the same import. Creation
+// failures are reported and do not stop the import.
+//
+// An error is returned only if the existing redirects cannot be listed or if
+// the context expires, in which case the report covers the redirects
+// processed so far.
+func (c *AdminClient) ImportRedirects(ctx context.Context, redirects []Redirect) (*RedirectImportReport, error) {
+	existing, err := c.GetAllRedirects(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing redirects: %w", err)
+	}
+
+	byPath := make(map[string]Redirect, len(existing))
+
+	for _, redirect := range existing {
+		byPath[redirect.Path] = redirect
+	}
+
+	report := &RedirectImportReport{}
+
+	for _, redirect := range redirects {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		if other, ok := byPath[redirect.Path]; ok {
+			if other.Target == redirect.Target {
+				report.Skipped = append(report.Skipped, other)
+			} else {
+				report.Conflicts = append(report.Conflicts, RedirectConflict{
+					Redirect: redirect,
+					Existing: other,
+				})
+			}
+
+			continue
+		}
+
+		created, err := c.CreateRedirect(ctx, Redirect{Path: redirect.Path, Target: redirect.Target})
+
+		if err != nil {
+			// The path is not recorded, so that a duplicate later in the
+			// input is attempted again rather than skipped.
+			report.Failures = append(report.Failures, RedirectFailure{
+				Redirect: redirect,
+				Err:      err,
+			})
+
+			continue
+		}
+
+		report.Created = append(report.Created, *created)
+		byPath[created.Path] = *created
+	}
+
+	return report, nil
+}
@@ -0,0 +1,81 @@
+package shopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestImportRedirects(t *testing.T) {
+	var created []string
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"redirects":[{"id":1,"path":"/old","target":"/new"},{"id":2,"path":"/taken","target":"/elsewhere"}]}`))
+		case http.MethodPost:
+			body := &redirectEnvelope{}
+
+			if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+				t.Errorf("expected no error but got: %s", err)
+				return
+			}
+
+			if body.Redirect.Target == "" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"errors":{"target":["can't be blank"]}}`))
+				return
+			}
+
+			created = append(created, body.Redirect.Path)
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"redirect":{"id":3,"path":"` + body.Redirect.Path + `","target":"` + body.Redirect.Target + `"}}`))
+		default:
+			t.Errorf("unexpected method `%s`", req.Method)
+		}
+	}))
+
+	report, err := client.ImportRedirects(ctx, []Redirect{
+		{Path: "/old", Target: "/new"},
+		{Path: "/taken", Target: "/mine"},
+		{Path: "/fresh", Target: "/target"},
+		{Path: "/fresh", Target: "/other"},
+		{Path: "/blank"},
+		{Path: "/blank"},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(created) != 1 || created[0] != "/fresh" {
+		t.Errorf("unexpected created redirects: %v", created)
+	}
+
+	if len(report.Created) != 1 || report.Created[0].ID != 3 {
+		t.Errorf("unexpected created redirects: %#v", report.Created)
+	}
+
+	if len(report.Skipped) != 1 || report.Skipped[0].ID != 1 {
+		t.Errorf("unexpected skipped redirects: %#v", report.Skipped)
+	}
+
+	if len(report.Conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts but got %d", len(report.Conflicts))
+	}
+
+	if conflict := report.Conflicts[0]; conflict.Redirect.Target != "/mine" || conflict.Existing.Target != "/elsewhere" {
+		t.Errorf("unexpected conflict: %#v", conflict)
+	}
+
+	if conflict := report.Conflicts[1]; conflict.Redirect.Target != "/other" || conflict.Existing.ID != 3 || conflict.Existing.Target != "/target" {
+		t.Errorf("unexpected conflict: %#v", conflict)
+	}
+
+	// Failed redirects are not recorded, so their duplicates are attempted
+	// again rather than skipped.
+	if len(report.Failures) != 2 || !IsUnprocessable(report.Failures[0].Err) || !IsUnprocessable(report.Failures[1].Err) {
+		t.Errorf("unexpected failures: %#v", report.Failures)
+	}
+}
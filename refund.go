@@ -0,0 +1,211 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// RefundID is an ID of a refund.
+type RefundID int
+
+// RefundRestockType represents how the items of a refunded line item are
+// restocked.
+type RefundRestockType string
+
+const (
+	// RefundRestockTypeNoRestock indicates that the items are not restocked.
+	RefundRestockTypeNoRestock RefundRestockType = "no_restock"
+	// RefundRestockTypeCancel indicates that the items were not delivered
+	// and are restocked.
+	RefundRestockTypeCancel RefundRestockType = "cancel"
+	// RefundRestockTypeReturn indicates that the items were returned and are
+	// restocked.
+	RefundRestockTypeReturn RefundRestockType = "return"
+)
+
+// RefundLineItemID is an ID of a refund line item.
+type RefundLineItemID int
+
+// RefundLineItem represents a refunded line item.
+type RefundLineItem struct {
+	ID          RefundLineItemID  `json:"id,omitempty"`
+	LineItemID  LineItemID        `json:"line_item_id,omitempty"`
+	Quantity    int               `json:"quantity,omitempty"`
+	RestockType RefundRestockType `json:"restock_type,omitempty"`
+	LocationID  LocationID        `json:"location_id,omitempty"`
+	Subtotal    *Money            `json:"subtotal,omitempty"`
+	SubtotalSet *MoneySet         `json:"subtotal_set,omitempty"`
+	TotalTax    *Money            `json:"total_tax,omitempty"`
+	TotalTaxSet *MoneySet         `json:"total_tax_set,omitempty"`
+	LineItem    *LineItem         `json:"line_item,omitempty"`
+}
+
+// RefundShipping represents the shipping costs to refund.
+type RefundShipping struct {
+	// FullRefund refunds all the remaining shipping costs.
+	FullRefund bool `json:"full_refund,omitempty"`
+
+	// Amount is the amount of shipping costs to refund.
+	Amount *Money `json:"amount,omitempty"`
+
+	// Tax and MaximumRefundable are returned when calculating a refund.
+	Tax               *Money `json:"tax,omitempty"`
+	MaximumRefundable *Money `json:"maximum_refundable,omitempty"`
+}
+
+// OrderAdjustment represents an adjustment of an order caused by a refund,
+// such as a refunded shipping cost.
+type OrderAdjustment struct {
+	ID           int       `json:"id,omitempty"`
+	OrderID      OrderID   `json:"order_id,omitempty"`
+	RefundID     RefundID  `json:"refund_id,omitempty"`
+	Kind         string    `json:"kind,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+	Amount       *Money    `json:"amount,omitempty"`
+	AmountSet    *MoneySet `json:"amount_set,omitempty"`
+	TaxAmount    *Money    `json:"tax_amount,omitempty"`
+	TaxAmountSet *MoneySet `json:"tax_amount_set,omitempty"`
+}
+
+// Refund represents a refund of an order.
+//
+// To create a refund, first calculate it with CalculateRefund, which returns
+// the transactions to create, then create it with CreateRefund.
+//
+// The amounts of the refund are in the currency indicated by Currency or, if
+// Shopify omits it, in the currency of the transactions.
+type Refund struct {
+	ID                RefundID          `json:"id,omitempty"`
+	OrderID           OrderID           `json:"order_id,omitempty"`
+	Note              string            `json:"note,omitempty"`
+	Notify            bool              `json:"notify,omitempty"`
+	Currency          CurrencyCode      `json:"currency,omitempty"`
+	UserID            int               `json:"user_id,omitempty"`
+	Shipping          *RefundShipping   `json:"shipping,omitempty"`
+	RefundLineItems   []RefundLineItem  `json:"refund_line_items,omitempty"`
+	Transactions      []Transaction     `json:"transactions,omitempty"`
+	OrderAdjustments  []OrderAdjustment `json:"order_adjustments,omitempty"`
+	AdminGraphQLAPIID string            `json:"admin_graphql_api_id,omitempty"`
+	ProcessedAt       *time.Time        `json:"processed_at,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// The amounts are given the currency of the refund.
+func (r *Refund) UnmarshalJSON(b []byte) error {
+	type refund Refund
+
+	if err := json.Unmarshal(b, (*refund)(r)); err != nil {
+		return err
+	}
+
+	if r.Currency == "" && len(r.Transactions) > 0 {
+		r.Currency = r.Transactions[0].Currency
+	}
+
+	setMoneyCurrency(reflect.ValueOf(r), r.Currency)
+
+	return nil
+}
+
+type refundEnvelope struct {
+	Refund Refund `json:"refund"`
+}
+
+// GetAllRefunds retrieves a list of all the refunds of an order.
+func (c *AdminClient) GetAllRefunds(ctx context.Context, orderID OrderID, fields SelectedFields) ([]Refund, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Refund
+
+	for page := 1; pagination != nil; page++ {
+		refunds, links, err := c.GetRefunds(ctx, orderID, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, refunds...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetRefunds retrieves a list of the refunds of an order.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllRefunds.
+func (c *AdminClient) GetRefunds(ctx context.Context, orderID OrderID, pagination *Pagination, fields SelectedFields) ([]Refund, *PageLinks, error) {
+	values := url.Values{}
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Refunds []Refund `json:"refunds"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/refunds.json", orderID), values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Refunds, parsePageLinks(header), nil
+}
+
+// GetRefund fetches a refund of an order by ID.
+//
+// If no such refund exists, a nil refund and no error is returned.
+func (c *AdminClient) GetRefund(ctx context.Context, orderID OrderID, id RefundID, fields SelectedFields) (*Refund, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &refundEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/refunds/%d.json", orderID, id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Refund, nil
+}
+
+// CalculateRefund calculates the transactions and amounts of a refund,
+// without creating it.
+//
+// The returned transactions have the `suggested_refund` kind: change it to
+// TransactionKindRefund before creating the refund with CreateRefund.
+func (c *AdminClient) CalculateRefund(ctx context.Context, orderID OrderID, refund Refund) (*Refund, error) {
+	body := &refundEnvelope{Refund: refund}
+	result := &refundEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("orders/%d/refunds/calculate.json", orderID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Refund, nil
+}
+
+// CreateRefund creates a refund.
+func (c *AdminClient) CreateRefund(ctx context.Context, orderID OrderID, refund Refund) (*Refund, error) {
+	body := &refundEnvelope{Refund: refund}
+	result := &refundEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("orders/%d/refunds.json", orderID), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Refund, nil
+}
@@ -0,0 +1,86 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestCalculateRefund(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if expected := "/admin/api/" + string(DefaultAPIVersion) + "/orders/1/refunds/calculate.json"; req.URL.Path != expected {
+			t.Errorf("expected `%s` but got `%s`", expected, req.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"refund":{"shipping":{"amount":"5.10"},"refund_line_items":[{"line_item_id":2,"quantity":1,"restock_type":"return"}]}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write([]byte(`{"refund":{"shipping":{"amount":"5.10","tax":"0.00","maximum_refundable":"5.10"},"refund_line_items":[{"line_item_id":2,"quantity":1,"restock_type":"return","subtotal":"195.67","total_tax":"3.98"}],"transactions":[{"parent_id":3,"amount":"204.75","kind":"suggested_refund","gateway":"bogus","currency":"USD"}]}}`))
+	}))
+
+	shipping := NewMoney(MustParseDecimal("5.10"), "USD")
+	refund, err := client.CalculateRefund(ctx, 1, Refund{
+		Shipping: &RefundShipping{Amount: &shipping},
+		RefundLineItems: []RefundLineItem{
+			{LineItemID: 2, Quantity: 1, RestockType: RefundRestockTypeReturn},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(refund.Transactions) != 1 {
+		t.Fatalf("expected one transaction but got %d", len(refund.Transactions))
+	}
+
+	transaction := refund.Transactions[0]
+	subtotal, err := refund.RefundLineItems[0].Subtotal.Add(*refund.RefundLineItems[0].TotalTax)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	total, err := subtotal.Add(*refund.Shipping.Amount)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if !transaction.Amount.Equal(total) {
+		t.Errorf("expected `%s` but got `%s`", total, transaction.Amount)
+	}
+
+	if transaction.ParentID != 3 {
+		t.Errorf("expected `3` but got `%d`", transaction.ParentID)
+	}
+}
+
+func TestCaptureTransaction(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"transaction":{"parent_id":3,"kind":"capture","amount":"10.00","currency":"USD"}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"transaction":{"id":4,"parent_id":3,"kind":"capture","status":"success","amount":"10.00","currency":"USD"}}`))
+	}))
+
+	amount := NewMoney(MustParseDecimal("10.00"), "USD")
+	transaction, err := client.CaptureTransaction(ctx, 1, 3, &amount)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if transaction.Status != TransactionStatusSuccess {
+		t.Errorf("expected `%s` but got `%s`", TransactionStatusSuccess, transaction.Status)
+	}
+}
@@ -0,0 +1,240 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy represents a policy for retrying failed requests.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first
+	// one.
+	//
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts.
+	//
+	// If zero, the delay is not capped.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor by which the delay grows after each attempt.
+	//
+	// If zero, the delay doubles after each attempt.
+	Multiplier float64
+
+	// Jitter is the fraction, between 0 and 1, by which the delay is randomly
+	// increased or decreased, to prevent concurrent callers from retrying
+	// in lockstep.
+	Jitter float64
+
+	// RetryableStatusCodes is the list of response status codes that cause
+	// a retry.
+	//
+	// If nil, DefaultRetryableStatusCodes is used.
+	RetryableStatusCodes []int
+
+	// RetryableMethods is the list of HTTP methods that can be retried.
+	//
+	// If nil, DefaultRetryableMethods is used. As POST requests are not
+	// idempotent, they are never retried unless explicitly listed.
+	//
+	// Requests that perform an action, such as completing a draft order,
+	// cancelling an order or capturing a transaction, are never retried,
+	// whatever their method: if the response is lost, a retry would fail
+	// even though the action succeeded.
+	RetryableMethods []string
+}
+
+var (
+	// DefaultRetryableStatusCodes is the default list of status codes that
+	// cause a retry.
+	DefaultRetryableStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+
+	// DefaultRetryableMethods is the default list of HTTP methods that can be
+	// retried.
+	DefaultRetryableMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete,
+	}
+)
+
+// DefaultRetryPolicy is the retry policy used by clients that do not specify
+// one.
+var DefaultRetryPolicy = &RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+	Multiplier:  2,
+	Jitter:      0.2,
+}
+
+// NoRetryPolicy is a retry policy that disables retries.
+var NoRetryPolicy = &RetryPolicy{
+	MaxAttempts: 1,
+}
+
+const headerRetryAfter = "Retry-After"
+
+// withoutRetry returns a context whose requests are never retried, for the
+// actions that are not idempotent.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyNoRetry, true)
+}
+
+func isRetryDisabled(ctx context.Context) bool {
+	noRetry, _ := ctx.Value(contextKeyNoRetry).(bool)
+
+	return noRetry
+}
+
+func (p *RetryPolicy) isRetryableMethod(method string) bool {
+	methods := p.RetryableMethods
+
+	if methods == nil {
+		methods = DefaultRetryableMethods
+	}
+
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *RetryPolicy) isRetryableStatusCode(statusCode int) bool {
+	statusCodes := p.RetryableStatusCodes
+
+	if statusCodes == nil {
+		statusCodes = DefaultRetryableStatusCodes
+	}
+
+	for _, s := range statusCodes {
+		if s == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay to wait after the specified failed attempt,
+// starting at 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+
+	if multiplier == 0 {
+		multiplier = 2
+	}
+
+	d := float64(p.MinBackoff) * math.Pow(multiplier, float64(attempt-1))
+
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		d *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+
+	return time.Duration(d)
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a
+// number of seconds (possibly decimal, as Shopify sends them) or an HTTP
+// date.
+func parseRetryAfter(s string, now time.Time) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if t, err := http.ParseTime(s); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// do sends a request using the specified function, retrying it according to
+// the policy.
+//
+// Requests with a body can only be retried if they have a GetBody function,
+// which is the case of all requests built by newRequest.
+func (p *RetryPolicy) do(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := p.isRetryableMethod(req.Method) && (req.Body == nil || req.GetBody != nil) && !isRetryDisabled(ctx)
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %s", err)
+			}
+
+			req.Body = body
+		}
+
+		resp, err := send(req)
+
+		if !retryable || attempt >= p.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+
+		if err == nil {
+			if !p.isRetryableStatusCode(resp.StatusCode) {
+				return resp, nil
+			}
+
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now()); ok {
+				delay = retryAfter
+			}
+
+			flushAndCloseBody(resp.Body)
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = &RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		S          string
+		Expected   time.Duration
+		ExpectedOK bool
+	}{
+		{"", 0, false},
+		{"2", 2 * time.Second, true},
+		{"2.0", 2 * time.Second, true},
+		{"0.5", 500 * time.Millisecond, true},
+		{"-1", 0, false},
+		{"Tue, 01 Jan 2019 00:00:03 GMT", 3 * time.Second, true},
+		{"Mon, 31 Dec 2018 00:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.S, func(t *testing.T) {
+			value, ok := parseRetryAfter(testCase.S, now)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("expected %t but got %t", testCase.ExpectedOK, ok)
+			}
+
+			if value != testCase.Expected {
+				t.Errorf("expected %s but got %s", testCase.Expected, value)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Second,
+		Multiplier: 2,
+	}
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if value := policy.backoff(attempt + 1); value != expected {
+			t.Errorf("expected %s but got %s", expected, value)
+		}
+	}
+
+	policy.Jitter = 0.5
+
+	for i := 0; i < 100; i++ {
+		if value := policy.backoff(1); value < 500*time.Millisecond || value > 1500*time.Millisecond {
+			t.Fatalf("backoff out of bounds: %s", value)
+		}
+	}
+}
+
+func TestRetryPolicyRetriesWithBody(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"script_tag":{"created_at":"0001-01-01T00:00:00Z","event":"onload","id":1,"src":"https://foo/a.js","updated_at":"0001-01-01T00:00:00Z"}}`
+
+		if string(body) != expected {
+			t.Errorf("attempt %d: expected `%s` but got `%s`", attempts, expected, string(body))
+		}
+
+		if attempts < 3 {
+			w.Header().Set(headerRetryAfter, "0.001")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Write(body)
+	}))
+	client.RetryPolicy = testRetryPolicy
+
+	if _, err := client.CreateOrUpdateScriptTag(ctx, ScriptTag{ID: 1, Src: "https://foo/a.js"}); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected %d attempts but got %d", 3, attempts)
+	}
+}
+
+func TestRetryPolicyGivesUp(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	client.RetryPolicy = testRetryPolicy
+
+	if _, err := client.GetScriptTagsCount(ctx); !hasStatusCode(err, http.StatusBadGateway) {
+		t.Fatalf("expected a bad gateway error but got: %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected %d attempts but got %d", 3, attempts)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryPOST(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	client.RetryPolicy = testRetryPolicy
+
+	if _, err := client.CreateOrUpdateScriptTag(ctx, ScriptTag{Src: "https://foo/a.js"}); !hasStatusCode(err, http.StatusServiceUnavailable) {
+		t.Fatalf("expected a service unavailable error but got: %v", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected %d attempts but got %d", 1, attempts)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryActions(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	client.RetryPolicy = testRetryPolicy
+
+	if _, err := client.CompleteDraftOrder(ctx, 1, false); !hasStatusCode(err, http.StatusServiceUnavailable) {
+		t.Fatalf("expected a service unavailable error but got: %v", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected %d attempts but got %d", 1, attempts)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	client.RetryPolicy = testRetryPolicy
+
+	if _, err := client.GetScriptTagsCount(ctx); !IsForbidden(err) {
+		t.Fatalf("expected a forbidden error but got: %v", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected %d attempts but got %d", 1, attempts)
+	}
+}
@@ -1,4 +1,40 @@
 package shopify
 
+import "time"
+
 // Shop represents a shop.
 type Shop string
+
+// ShopDetails represents the settings of a shop.
+//
+// It is notably the payload of the "shop/update" and "app/uninstalled"
+// webhooks.
+type ShopDetails struct {
+	ID                int            `json:"id"`
+	Name              string         `json:"name"`
+	Email             string         `json:"email"`
+	CustomerEmail     string         `json:"customer_email"`
+	Domain            string         `json:"domain"`
+	MyshopifyDomain   Shop           `json:"myshopify_domain"`
+	ShopOwner         string         `json:"shop_owner"`
+	Phone             string         `json:"phone"`
+	Address1          string         `json:"address1"`
+	Address2          string         `json:"address2"`
+	City              string         `json:"city"`
+	Zip               string         `json:"zip"`
+	Province          string         `json:"province"`
+	ProvinceCode      string         `json:"province_code"`
+	Country           string         `json:"country"`
+	CountryCode       string         `json:"country_code"`
+	Currency          CurrencyCode   `json:"currency"`
+	EnabledCurrencies []CurrencyCode `json:"enabled_presentment_currencies"`
+	MoneyFormat       string         `json:"money_format"`
+	IANATimezone      string         `json:"iana_timezone"`
+	PlanName          string         `json:"plan_name"`
+	PlanDisplayName   string         `json:"plan_display_name"`
+	PasswordEnabled   bool           `json:"password_enabled"`
+	TaxesIncluded     bool           `json:"taxes_included"`
+	WeightUnit        string         `json:"weight_unit"`
+	CreatedAt         *time.Time     `json:"created_at"`
+	UpdatedAt         *time.Time     `json:"updated_at"`
+}
@@ -0,0 +1,433 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SmartCollectionRuleColumn represents the product property a smart
+// collection rule applies to.
+type SmartCollectionRuleColumn string
+
+const (
+	// SmartCollectionRuleColumnTitle is the product title.
+	SmartCollectionRuleColumnTitle SmartCollectionRuleColumn = "title"
+	// SmartCollectionRuleColumnType is the product type.
+	SmartCollectionRuleColumnType SmartCollectionRuleColumn = "type"
+	// SmartCollectionRuleColumnVendor is the product vendor.
+	SmartCollectionRuleColumnVendor SmartCollectionRuleColumn = "vendor"
+	// SmartCollectionRuleColumnVariantTitle is the title of a product
+	// variant.
+	SmartCollectionRuleColumnVariantTitle SmartCollectionRuleColumn = "variant_title"
+	// SmartCollectionRuleColumnTag is a product tag.
+	SmartCollectionRuleColumnTag SmartCollectionRuleColumn = "tag"
+	// SmartCollectionRuleColumnVariantPrice is the price of a product
+	// variant.
+	SmartCollectionRuleColumnVariantPrice SmartCollectionRuleColumn = "variant_price"
+	// SmartCollectionRuleColumnVariantCompareAtPrice is the compare-at price
+	// of a product variant.
+	SmartCollectionRuleColumnVariantCompareAtPrice SmartCollectionRuleColumn = "variant_compare_at_price"
+	// SmartCollectionRuleColumnVariantWeight is the weight of a product
+	// variant.
+	SmartCollectionRuleColumnVariantWeight SmartCollectionRuleColumn = "variant_weight"
+	// SmartCollectionRuleColumnVariantInventory is the inventory quantity of
+	// a product variant.
+	SmartCollectionRuleColumnVariantInventory SmartCollectionRuleColumn = "variant_inventory"
+	// SmartCollectionRuleColumnIsPriceReduced indicates whether a product
+	// variant has a compare-at price greater than its price.
+	SmartCollectionRuleColumnIsPriceReduced SmartCollectionRuleColumn = "is_price_reduced"
+)
+
+// SmartCollectionRuleRelation represents the relation between a product
+// property and the condition of a smart collection rule.
+type SmartCollectionRuleRelation string
+
+const (
+	// SmartCollectionRuleRelationEquals matches equal values.
+	SmartCollectionRuleRelationEquals SmartCollectionRuleRelation = "equals"
+	// SmartCollectionRuleRelationNotEquals matches different values.
+	SmartCollectionRuleRelationNotEquals SmartCollectionRuleRelation = "not_equals"
+	// SmartCollectionRuleRelationGreaterThan matches greater values.
+	SmartCollectionRuleRelationGreaterThan SmartCollectionRuleRelation = "greater_than"
+	// SmartCollectionRuleRelationLessThan matches lesser values.
+	SmartCollectionRuleRelationLessThan SmartCollectionRuleRelation = "less_than"
+	// SmartCollectionRuleRelationStartsWith matches values that start with
+	// the condition.
+	SmartCollectionRuleRelationStartsWith SmartCollectionRuleRelation = "starts_with"
+	// SmartCollectionRuleRelationEndsWith matches values that end with the
+	// condition.
+	SmartCollectionRuleRelationEndsWith SmartCollectionRuleRelation = "ends_with"
+	// SmartCollectionRuleRelationContains matches values that contain the
+	// condition.
+	SmartCollectionRuleRelationContains SmartCollectionRuleRelation = "contains"
+	// SmartCollectionRuleRelationNotContains matches values that do not
+	// contain the condition.
+	SmartCollectionRuleRelationNotContains SmartCollectionRuleRelation = "not_contains"
+	// SmartCollectionRuleRelationIsSet matches set values.
+	SmartCollectionRuleRelationIsSet SmartCollectionRuleRelation = "is_set"
+	// SmartCollectionRuleRelationIsNotSet matches unset values.
+	SmartCollectionRuleRelationIsNotSet SmartCollectionRuleRelation = "is_not_set"
+)
+
+type smartCollectionRuleColumnKind int
+
+const (
+	smartCollectionRuleColumnKindText smartCollectionRuleColumnKind = iota
+	smartCollectionRuleColumnKindNumber
+	smartCollectionRuleColumnKindFlag
+)
+
+var smartCollectionRuleColumnKinds = map[SmartCollectionRuleColumn]smartCollectionRuleColumnKind{
+	SmartCollectionRuleColumnTitle:                 smartCollectionRuleColumnKindText,
+	SmartCollectionRuleColumnType:                  smartCollectionRuleColumnKindText,
+	SmartCollectionRuleColumnVendor:                smartCollectionRuleColumnKindText,
+	SmartCollectionRuleColumnVariantTitle:          smartCollectionRuleColumnKindText,
+	SmartCollectionRuleColumnTag:                   smartCollectionRuleColumnKindText,
+	SmartCollectionRuleColumnVariantPrice:          smartCollectionRuleColumnKindNumber,
+	SmartCollectionRuleColumnVariantCompareAtPrice: smartCollectionRuleColumnKindNumber,
+	SmartCollectionRuleColumnVariantWeight:         smartCollectionRuleColumnKindNumber,
+	SmartCollectionRuleColumnVariantInventory:      smartCollectionRuleColumnKindNumber,
+	SmartCollectionRuleColumnIsPriceReduced:        smartCollectionRuleColumnKindFlag,
+}
+
+// smartCollectionRuleRelations lists the legal relations of each column, as
+// documented by Shopify.
+var smartCollectionRuleRelations = map[SmartCollectionRuleColumn][]SmartCollectionRuleRelation{
+	SmartCollectionRuleColumnTitle:                 textRelations,
+	SmartCollectionRuleColumnType:                  textRelations,
+	SmartCollectionRuleColumnVendor:                textRelations,
+	SmartCollectionRuleColumnVariantTitle:          textRelations,
+	SmartCollectionRuleColumnTag:                   {SmartCollectionRuleRelationEquals},
+	SmartCollectionRuleColumnVariantPrice:          numberRelations,
+	SmartCollectionRuleColumnVariantCompareAtPrice: numberRelations,
+	SmartCollectionRuleColumnVariantWeight:         numberRelations,
+	SmartCollectionRuleColumnVariantInventory:      numberRelations,
+	SmartCollectionRuleColumnIsPriceReduced:        {SmartCollectionRuleRelationIsSet, SmartCollectionRuleRelationIsNotSet},
+}
+
+var textRelations = []SmartCollectionRuleRelation{
+	SmartCollectionRuleRelationEquals,
+	SmartCollectionRuleRelationNotEquals,
+	SmartCollectionRuleRelationStartsWith,
+	SmartCollectionRuleRelationEndsWith,
+	SmartCollectionRuleRelationContains,
+	SmartCollectionRuleRelationNotContains,
+}
+
+var numberRelations = []SmartCollectionRuleRelation{
+	SmartCollectionRuleRelationEquals,
+	SmartCollectionRuleRelationNotEquals,
+	SmartCollectionRuleRelationGreaterThan,
+	SmartCollectionRuleRelationLessThan,
+}
+
+// SmartCollectionRule represents a rule that products must match to be part
+// of a smart collection.
+type SmartCollectionRule struct {
+	Column    SmartCollectionRuleColumn   `json:"column"`
+	Relation  SmartCollectionRuleRelation `json:"relation"`
+	Condition string                      `json:"condition"`
+}
+
+// Validate checks that the rule combines a known column with one of its
+// legal relations, and that the condition is valid for the column.
+func (r SmartCollectionRule) Validate() error {
+	kind, ok := smartCollectionRuleColumnKinds[r.Column]
+
+	if !ok {
+		return fmt.Errorf("unknown smart collection rule column `%s`", r.Column)
+	}
+
+	legal := false
+
+	for _, relation := range smartCollectionRuleRelations[r.Column] {
+		if relation == r.Relation {
+			legal = true
+			break
+		}
+	}
+
+	if !legal {
+		return fmt.Errorf("relation `%s` cannot be used with column `%s`", r.Relation, r.Column)
+	}
+
+	switch kind {
+	case smartCollectionRuleColumnKindText:
+		if r.Condition == "" {
+			return fmt.Errorf("column `%s` requires a condition", r.Column)
+		}
+	case smartCollectionRuleColumnKindNumber:
+		if _, err := strconv.ParseFloat(r.Condition, 64); err != nil {
+			return fmt.Errorf("column `%s` requires a numeric condition but got `%s`", r.Column, r.Condition)
+		}
+	}
+
+	return nil
+}
+
+// SmartCollectionRulesBuilder builds a validated list of smart collection
+// rules.
+//
+// The first invalid rule is reported when calling Apply or Rules:
+//
+//	err := shopify.NewSmartCollectionRulesBuilder().
+//		Where(shopify.SmartCollectionRuleColumnVendor, shopify.SmartCollectionRuleRelationEquals, "Acme").
+//		Where(shopify.SmartCollectionRuleColumnVariantPrice, shopify.SmartCollectionRuleRelationLessThan, "20").
+//		MatchAll().
+//		Apply(&collection)
+type SmartCollectionRulesBuilder struct {
+	rules       []SmartCollectionRule
+	disjunctive bool
+	err         error
+}
+
+// NewSmartCollectionRulesBuilder instantiates a new rules builder.
+//
+// By default, products must match all the rules.
+func NewSmartCollectionRulesBuilder() *SmartCollectionRulesBuilder {
+	return &SmartCollectionRulesBuilder{}
+}
+
+// Where adds a rule.
+func (b *SmartCollectionRulesBuilder) Where(column SmartCollectionRuleColumn, relation SmartCollectionRuleRelation, condition string) *SmartCollectionRulesBuilder {
+	rule := SmartCollectionRule{
+		Column:    column,
+		Relation:  relation,
+		Condition: condition,
+	}
+
+	if err := rule.Validate(); err != nil && b.err == nil {
+		b.err = fmt.Errorf("rule %d: %s", len(b.rules)+1, err)
+	}
+
+	b.rules = append(b.rules, rule)
+
+	return b
+}
+
+// MatchAll makes products match the collection only if they match all the
+// rules.
+func (b *SmartCollectionRulesBuilder) MatchAll() *SmartCollectionRulesBuilder {
+	b.disjunctive = false
+
+	return b
+}
+
+// MatchAny makes products match the collection if they match any of the
+// rules.
+func (b *SmartCollectionRulesBuilder) MatchAny() *SmartCollectionRulesBuilder {
+	b.disjunctive = true
+
+	return b
+}
+
+// Rules returns the rules and the disjunctive flag, or the first error.
+func (b *SmartCollectionRulesBuilder) Rules() ([]SmartCollectionRule, bool, error) {
+	if b.err != nil {
+		return nil, false, b.err
+	}
+
+	if len(b.rules) == 0 {
+		return nil, false, errors.New("a smart collection requires at least one rule")
+	}
+
+	rules := make([]SmartCollectionRule, len(b.rules))
+	copy(rules, b.rules)
+
+	return rules, b.disjunctive, nil
+}
+
+// Apply sets the rules and the disjunctive flag of a smart collection.
+//
+// If any rule is invalid, the collection is left untouched and an error is
+// returned.
+func (b *SmartCollectionRulesBuilder) Apply(collection *SmartCollection) error {
+	rules, disjunctive, err := b.Rules()
+
+	if err != nil {
+		return err
+	}
+
+	collection.Rules = rules
+	collection.Disjunctive = &disjunctive
+
+	return nil
+}
+
+// SmartCollection represents a smart collection, whose products are selected
+// automatically according to rules.
+//
+// Empty fields are omitted when creating or updating a collection, so that
+// only the specified fields are changed.
+type SmartCollection struct {
+	ID                CollectionID          `json:"id,omitempty"`
+	Handle            string                `json:"handle,omitempty"`
+	Title             string                `json:"title,omitempty"`
+	BodyHTML          string                `json:"body_html,omitempty"`
+	SortOrder         CollectionSortOrder   `json:"sort_order,omitempty"`
+	TemplateSuffix    string                `json:"template_suffix,omitempty"`
+	PublishedScope    string                `json:"published_scope,omitempty"`
+	Published         *bool                 `json:"published,omitempty"`
+	Image             *CollectionImage      `json:"image,omitempty"`
+	Rules             []SmartCollectionRule `json:"rules,omitempty"`
+	AdminGraphQLAPIID string                `json:"admin_graphql_api_id,omitempty"`
+	PublishedAt       *time.Time            `json:"published_at,omitempty"`
+	UpdatedAt         *time.Time            `json:"updated_at,omitempty"`
+
+	// Disjunctive indicates whether products must match any of the rules
+	// (true) or all of them (false).
+	Disjunctive *bool `json:"disjunctive,omitempty"`
+}
+
+// validateRules validates the rules whose column is known.
+//
+// Shopify keeps adding rule columns: the rules that use a column unknown to
+// this package are left for Shopify to validate, so that a collection can be
+// sent back as it was fetched.
+func (s SmartCollection) validateRules() error {
+	for i, rule := range s.Rules {
+		if _, ok := smartCollectionRuleColumnKinds[rule.Column]; !ok {
+			continue
+		}
+
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule %d: %s", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+type smartCollectionEnvelope struct {
+	SmartCollection SmartCollection `json:"smart_collection"`
+}
+
+// GetAllSmartCollections retrieves a list of all smart collections matching
+// the filter.
+func (c *AdminClient) GetAllSmartCollections(ctx context.Context, filter *CollectionFilter, fields SelectedFields) ([]SmartCollection, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []SmartCollection
+
+	for page := 1; pagination != nil; page++ {
+		collections, links, err := c.GetSmartCollections(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, collections...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetSmartCollections retrieves a list of smart collections matching the
+// filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllSmartCollections.
+func (c *AdminClient) GetSmartCollections(ctx context.Context, filter *CollectionFilter, pagination *Pagination, fields SelectedFields) ([]SmartCollection, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		SmartCollections []SmartCollection `json:"smart_collections"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "smart_collections.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.SmartCollections, parsePageLinks(header), nil
+}
+
+// GetSmartCollectionsCount retrieves the count of all smart collections
+// matching the filter.
+func (c *AdminClient) GetSmartCollectionsCount(ctx context.Context, filter *CollectionFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+	values.Del("ids")
+
+	return c.getCount(ctx, "smart_collections/count.json", values)
+}
+
+// GetSmartCollection fetches a smart collection by ID.
+//
+// If no such collection exists, a nil collection and no error is returned.
+func (c *AdminClient) GetSmartCollection(ctx context.Context, id CollectionID, fields SelectedFields) (*SmartCollection, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &smartCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("smart_collections/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.SmartCollection, nil
+}
+
+// CreateSmartCollection creates a smart collection.
+//
+// The rules whose column is known are validated before the request is sent.
+func (c *AdminClient) CreateSmartCollection(ctx context.Context, collection SmartCollection) (*SmartCollection, error) {
+	if err := collection.validateRules(); err != nil {
+		return nil, err
+	}
+
+	body := &smartCollectionEnvelope{SmartCollection: collection}
+	result := &smartCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "smart_collections.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.SmartCollection, nil
+}
+
+// UpdateSmartCollection updates a smart collection.
+//
+// Only the non-empty fields of the collection are updated. The rules whose
+// column is known are validated before the request is sent.
+func (c *AdminClient) UpdateSmartCollection(ctx context.Context, collection SmartCollection) (*SmartCollection, error) {
+	if err := collection.validateRules(); err != nil {
+		return nil, err
+	}
+
+	body := &smartCollectionEnvelope{SmartCollection: collection}
+	result := &smartCollectionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("smart_collections/%d.json", collection.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.SmartCollection, nil
+}
+
+// DeleteSmartCollection deletes a smart collection.
+func (c *AdminClient) DeleteSmartCollection(ctx context.Context, id CollectionID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("smart_collections/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
@@ -0,0 +1,116 @@
+package shopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSmartCollectionRuleValidate(t *testing.T) {
+	testCases := []struct {
+		Rule  SmartCollectionRule
+		Valid bool
+	}{
+		{SmartCollectionRule{"title", "contains", "shirt"}, true},
+		{SmartCollectionRule{"title", "greater_than", "shirt"}, false},
+		{SmartCollectionRule{"title", "contains", ""}, false},
+		{SmartCollectionRule{"tag", "equals", "sale"}, true},
+		{SmartCollectionRule{"tag", "contains", "sale"}, false},
+		{SmartCollectionRule{"variant_price", "less_than", "19.99"}, true},
+		{SmartCollectionRule{"variant_price", "less_than", "cheap"}, false},
+		{SmartCollectionRule{"variant_price", "starts_with", "1"}, false},
+		{SmartCollectionRule{"is_price_reduced", "is_set", ""}, true},
+		{SmartCollectionRule{"is_price_reduced", "equals", "true"}, false},
+		{SmartCollectionRule{"color", "equals", "red"}, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.Rule.Column)+" "+string(testCase.Rule.Relation), func(t *testing.T) {
+			err := testCase.Rule.Validate()
+
+			if testCase.Valid && err != nil {
+				t.Errorf("expected no error but got: %s", err)
+			}
+
+			if !testCase.Valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestSmartCollectionRulesBuilder(t *testing.T) {
+	collection := SmartCollection{Title: "Cheap Acme"}
+
+	err := NewSmartCollectionRulesBuilder().
+		Where(SmartCollectionRuleColumnVendor, SmartCollectionRuleRelationEquals, "Acme").
+		Where(SmartCollectionRuleColumnVariantPrice, SmartCollectionRuleRelationLessThan, "20").
+		MatchAny().
+		Apply(&collection)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	expected := []SmartCollectionRule{
+		{SmartCollectionRuleColumnVendor, SmartCollectionRuleRelationEquals, "Acme"},
+		{SmartCollectionRuleColumnVariantPrice, SmartCollectionRuleRelationLessThan, "20"},
+	}
+
+	if !reflect.DeepEqual(collection.Rules, expected) {
+		t.Errorf("expected: %#v\ngot: %#v", expected, collection.Rules)
+	}
+
+	if collection.Disjunctive == nil || !*collection.Disjunctive {
+		t.Errorf("expected the collection to be disjunctive")
+	}
+
+	collection = SmartCollection{}
+
+	err = NewSmartCollectionRulesBuilder().
+		Where(SmartCollectionRuleColumnVendor, SmartCollectionRuleRelationEquals, "Acme").
+		Where(SmartCollectionRuleColumnTag, SmartCollectionRuleRelationLessThan, "20").
+		Apply(&collection)
+
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if collection.Rules != nil {
+		t.Errorf("expected the collection to be left untouched")
+	}
+
+	if _, _, err = NewSmartCollectionRulesBuilder().Rules(); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestUpdateSmartCollectionUnknownColumn(t *testing.T) {
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"smart_collection":{"id":1,"rules":[{"column":"product_category_id","relation":"equals","condition":"123"}]}}`
+
+		if string(body) != expected {
+			t.Errorf("expected: %s\ngot: %s", expected, string(body))
+		}
+
+		w.Write(body)
+	}))
+
+	_, err := client.UpdateSmartCollection(ctx, SmartCollection{
+		ID:    1,
+		Rules: []SmartCollectionRule{{"product_category_id", "equals", "123"}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if _, err := client.UpdateSmartCollection(ctx, SmartCollection{
+		ID:    1,
+		Rules: []SmartCollectionRule{{SmartCollectionRuleColumnTag, SmartCollectionRuleRelationLessThan, "20"}},
+	}); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,135 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ThemeID is an ID of a theme.
+type ThemeID int
+
+// ThemeRole represents the role of a theme.
+type ThemeRole string
+
+const (
+	// ThemeRoleMain indicates that the theme is published.
+	ThemeRoleMain ThemeRole = "main"
+	// ThemeRoleUnpublished indicates that the theme is not published.
+	ThemeRoleUnpublished ThemeRole = "unpublished"
+	// ThemeRoleDemo indicates that the theme is a demo theme, which cannot
+	// be published.
+	ThemeRoleDemo ThemeRole = "demo"
+	// ThemeRoleDevelopment indicates that the theme is a temporary
+	// development theme.
+	ThemeRoleDevelopment ThemeRole = "development"
+)
+
+// Theme represents a theme of the online store.
+//
+// Empty fields are omitted when creating or updating a theme, so that only
+// the specified fields are changed.
+type Theme struct {
+	ID                ThemeID    `json:"id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Role              ThemeRole  `json:"role,omitempty"`
+	Previewable       bool       `json:"previewable,omitempty"`
+	Processing        bool       `json:"processing,omitempty"`
+	ThemeStoreID      int        `json:"theme_store_id,omitempty"`
+	AdminGraphQLAPIID string     `json:"admin_graphql_api_id,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+
+	// Src can be set when creating a theme, to the URL of a ZIP archive of
+	// the theme. It is never returned.
+	Src string `json:"src,omitempty"`
+}
+
+type themeEnvelope struct {
+	Theme Theme `json:"theme"`
+}
+
+// GetThemes retrieves the list of all the themes.
+func (c *AdminClient) GetThemes(ctx context.Context) ([]Theme, error) {
+	result := &struct {
+		Themes []Theme `json:"themes"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, "themes.json", nil, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Themes, nil
+}
+
+// GetMainTheme fetches the published theme.
+//
+// If no theme is published, a nil theme and no error is returned.
+func (c *AdminClient) GetMainTheme(ctx context.Context) (*Theme, error) {
+	themes, err := c.GetThemes(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, theme := range themes {
+		if theme.Role == ThemeRoleMain {
+			return &theme, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetTheme fetches a theme by ID.
+//
+// If no such theme exists, a nil theme and no error is returned.
+func (c *AdminClient) GetTheme(ctx context.Context, id ThemeID) (*Theme, error) {
+	result := &themeEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("themes/%d.json", id), nil, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Theme, nil
+}
+
+// CreateTheme creates a theme.
+func (c *AdminClient) CreateTheme(ctx context.Context, theme Theme) (*Theme, error) {
+	body := &themeEnvelope{Theme: theme}
+	result := &themeEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "themes.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Theme, nil
+}
+
+// UpdateTheme updates a theme.
+//
+// Setting the role to ThemeRoleMain publishes the theme.
+func (c *AdminClient) UpdateTheme(ctx context.Context, theme Theme) (*Theme, error) {
+	body := &themeEnvelope{Theme: theme}
+	result := &themeEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("themes/%d.json", theme.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Theme, nil
+}
+
+// DeleteTheme deletes a theme.
+//
+// The published theme cannot be deleted.
+func (c *AdminClient) DeleteTheme(ctx context.Context, id ThemeID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("themes/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
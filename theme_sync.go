@@ -0,0 +1,203 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// themeDirectories lists the top-level directories of a theme. Local files
+// outside of them are not synchronized.
+var themeDirectories = map[string]bool{
+	"assets":    true,
+	"blocks":    true,
+	"config":    true,
+	"layout":    true,
+	"locales":   true,
+	"sections":  true,
+	"snippets":  true,
+	"templates": true,
+}
+
+// ThemeSyncOptions represents the options of a theme synchronization.
+type ThemeSyncOptions struct {
+	// DryRun indicates that the plan must be computed but not applied.
+	DryRun bool
+
+	// KeepRemote indicates that the assets that do not exist locally must
+	// not be deleted.
+	KeepRemote bool
+}
+
+// ThemeSyncPlan represents the changes made, or to be made, to synchronize a
+// theme with a local directory.
+type ThemeSyncPlan struct {
+	// Uploads are the keys of the assets that are new or changed locally.
+	Uploads []string
+
+	// Deletes are the keys of the assets that do not exist locally.
+	Deletes []string
+
+	// Unchanged is the number of assets that are identical.
+	Unchanged int
+}
+
+// IsEmpty returns true if the plan contains no change.
+func (p *ThemeSyncPlan) IsEmpty() bool {
+	return len(p.Uploads) == 0 && len(p.Deletes) == 0
+}
+
+// String returns a human-readable description of the plan, with one line per
+// change.
+func (p *ThemeSyncPlan) String() string {
+	var b strings.Builder
+
+	for _, key := range p.Uploads {
+		fmt.Fprintf(&b, "upload %s\n", key)
+	}
+
+	for _, key := range p.Deletes {
+		fmt.Fprintf(&b, "delete %s\n", key)
+	}
+
+	fmt.Fprintf(&b, "%d uploaded, %d deleted, %d unchanged\n", len(p.Uploads), len(p.Deletes), p.Unchanged)
+
+	return b.String()
+}
+
+// SyncThemeDirectory synchronizes a theme with a local directory that has
+// the standard theme layout (`assets`, `config`, `layout`, `templates`...).
+//
+// Local files and assets are compared by checksum: only the files that are
+// new or changed are uploaded and, unless KeepRemote is set, the assets that
+// do not exist locally are deleted. Options may be nil.
+//
+// The returned plan describes the changes. If an error occurs while applying
+// them, the plan is returned along with the error.
+func (c *AdminClient) SyncThemeDirectory(ctx context.Context, themeID ThemeID, dir string, options *ThemeSyncOptions) (*ThemeSyncPlan, error) {
+	if options == nil {
+		options = &ThemeSyncOptions{}
+	}
+
+	local, err := readThemeDirectory(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	assets, err := c.GetAssets(ctx, themeID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assets: %w", err)
+	}
+
+	plan := &ThemeSyncPlan{}
+	remote := make(map[string]string, len(assets))
+
+	for _, asset := range assets {
+		remote[asset.Key] = asset.Checksum
+	}
+
+	for key, content := range local {
+		if checksum, ok := remote[key]; ok && checksum == AssetChecksum(content) {
+			plan.Unchanged++
+		} else {
+			plan.Uploads = append(plan.Uploads, key)
+		}
+	}
+
+	if !options.KeepRemote {
+		for key := range remote {
+			if _, ok := local[key]; !ok {
+				plan.Deletes = append(plan.Deletes, key)
+			}
+		}
+	}
+
+	sort.Strings(plan.Uploads)
+	sort.Strings(plan.Deletes)
+
+	if options.DryRun {
+		return plan, nil
+	}
+
+	for _, key := range plan.Uploads {
+		asset := Asset{Key: key}
+		content := local[key]
+
+		if utf8.Valid(content) {
+			asset.Value = string(content)
+		} else {
+			asset.Attachment = content
+		}
+
+		if _, err := c.PutAsset(ctx, themeID, asset); err != nil {
+			return plan, fmt.Errorf("failed to upload `%s`: %w", key, err)
+		}
+	}
+
+	for _, key := range plan.Deletes {
+		if err := c.DeleteAsset(ctx, themeID, key); err != nil {
+			return plan, fmt.Errorf("failed to delete `%s`: %w", key, err)
+		}
+	}
+
+	return plan, nil
+}
+
+// readThemeDirectory reads the files of a local theme directory, indexed by
+// asset key.
+func readThemeDirectory(dir string) (map[string][]byte, error) {
+	result := map[string][]byte{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+
+		if i := strings.Index(key, "/"); i < 0 || !themeDirectories[key[:i]] {
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		result[key] = content
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme directory `%s`: %s", dir, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func writeTestThemeFile(t *testing.T, dir string, key string, content string) {
+	path := filepath.Join(dir, filepath.FromSlash(key))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+}
+
+func TestSyncThemeDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeTestThemeFile(t, dir, "layout/theme.liquid", "unchanged")
+	writeTestThemeFile(t, dir, "templates/index.liquid", "changed")
+	writeTestThemeFile(t, dir, "assets/logo.png", "\x89PNG\xff")
+	writeTestThemeFile(t, dir, "README.md", "not part of the theme")
+	writeTestThemeFile(t, dir, "assets/.DS_Store", "hidden")
+
+	var lock sync.Mutex
+	var changes []string
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		switch req.Method {
+		case http.MethodGet:
+			fmt.Fprintf(w, `{"assets":[{"key":"layout/theme.liquid","checksum":"%s"},{"key":"templates/index.liquid","checksum":"%s"},{"key":"snippets/old.liquid","checksum":"%s"}]}`,
+				AssetChecksum([]byte("unchanged")),
+				AssetChecksum([]byte("original")),
+				AssetChecksum([]byte("old")),
+			)
+		case http.MethodPut:
+			body := &assetEnvelope{}
+			json.NewDecoder(req.Body).Decode(body)
+
+			if body.Asset.Value != "" {
+				changes = append(changes, "value "+body.Asset.Key)
+			} else {
+				changes = append(changes, "attachment "+body.Asset.Key)
+			}
+
+			w.Write([]byte(`{"asset":{}}`))
+		case http.MethodDelete:
+			changes = append(changes, "delete "+req.URL.Query().Get("asset[key]"))
+			w.Write([]byte(`{"message":"deleted"}`))
+		}
+	}))
+
+	plan, err := client.SyncThemeDirectory(ctx, 1, dir, &ThemeSyncOptions{DryRun: true})
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	expected := "upload assets/logo.png\nupload templates/index.liquid\ndelete snippets/old.liquid\n2 uploaded, 1 deleted, 1 unchanged\n"
+
+	if plan.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, plan.String())
+	}
+
+	if len(changes) != 0 {
+		t.Errorf("expected no changes during a dry run but got: %v", changes)
+	}
+
+	if _, err = client.SyncThemeDirectory(ctx, 1, dir, nil); err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	sort.Strings(changes)
+
+	if expected := "[attachment assets/logo.png delete snippets/old.liquid value templates/index.liquid]"; fmt.Sprint(changes) != expected {
+		t.Errorf("expected `%s` but got `%s`", expected, fmt.Sprint(changes))
+	}
+}
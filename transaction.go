@@ -0,0 +1,185 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// TransactionID is an ID of a transaction.
+type TransactionID int
+
+// TransactionKind represents the kind of a transaction.
+type TransactionKind string
+
+const (
+	// TransactionKindAuthorization reserves money that the customer agreed
+	// to pay.
+	TransactionKindAuthorization TransactionKind = "authorization"
+	// TransactionKindCapture transfers previously authorized money.
+	TransactionKindCapture TransactionKind = "capture"
+	// TransactionKindSale authorizes and captures money at once.
+	TransactionKindSale TransactionKind = "sale"
+	// TransactionKindVoid cancels a pending authorization or capture.
+	TransactionKindVoid TransactionKind = "void"
+	// TransactionKindRefund gives back captured money to the customer.
+	TransactionKindRefund TransactionKind = "refund"
+)
+
+// TransactionStatus represents the status of a transaction.
+type TransactionStatus string
+
+const (
+	// TransactionStatusPending indicates that the transaction is pending.
+	TransactionStatusPending TransactionStatus = "pending"
+	// TransactionStatusFailure indicates that the transaction failed.
+	TransactionStatusFailure TransactionStatus = "failure"
+	// TransactionStatusSuccess indicates that the transaction succeeded.
+	TransactionStatusSuccess TransactionStatus = "success"
+	// TransactionStatusError indicates that an error occurred.
+	TransactionStatusError TransactionStatus = "error"
+)
+
+// Transaction represents a money transaction on an order.
+//
+// Amount is in the currency indicated by Currency. When creating a
+// transaction, Currency defaults to the currency of Amount.
+type Transaction struct {
+	ID                TransactionID     `json:"id,omitempty"`
+	OrderID           OrderID           `json:"order_id,omitempty"`
+	ParentID          TransactionID     `json:"parent_id,omitempty"`
+	Kind              TransactionKind   `json:"kind,omitempty"`
+	Status            TransactionStatus `json:"status,omitempty"`
+	Gateway           string            `json:"gateway,omitempty"`
+	Amount            *Money            `json:"amount,omitempty"`
+	Currency          CurrencyCode      `json:"currency,omitempty"`
+	Authorization     string            `json:"authorization,omitempty"`
+	ErrorCode         string            `json:"error_code,omitempty"`
+	Message           string            `json:"message,omitempty"`
+	SourceName        string            `json:"source_name,omitempty"`
+	Test              bool              `json:"test,omitempty"`
+	Receipt           json.RawMessage   `json:"receipt,omitempty"`
+	AdminGraphQLAPIID string            `json:"admin_graphql_api_id,omitempty"`
+	ProcessedAt       *time.Time        `json:"processed_at,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+}
+
+// UnmarshalJSON implements JSON unmarshalling.
+//
+// The amounts are given the currency of the transaction.
+func (t *Transaction) UnmarshalJSON(b []byte) error {
+	type transaction Transaction
+
+	if err := json.Unmarshal(b, (*transaction)(t)); err != nil {
+		return err
+	}
+
+	setMoneyCurrency(reflect.ValueOf(t), t.Currency)
+
+	return nil
+}
+
+type transactionEnvelope struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+// GetTransactions retrieves the list of all the transactions of an order.
+//
+// If sinceID is not zero, only the transactions after it are returned.
+func (c *AdminClient) GetTransactions(ctx context.Context, orderID OrderID, sinceID TransactionID, fields SelectedFields) ([]Transaction, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	if sinceID != 0 {
+		values.Set("since_id", strconv.Itoa(int(sinceID)))
+	}
+
+	result := &struct {
+		Transactions []Transaction `json:"transactions"`
+	}{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/transactions.json", orderID), values, nil, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result.Transactions, nil
+}
+
+// GetTransactionsCount retrieves the count of the transactions of an order.
+func (c *AdminClient) GetTransactionsCount(ctx context.Context, orderID OrderID) (int, error) {
+	return c.getCount(ctx, fmt.Sprintf("orders/%d/transactions/count.json", orderID), nil)
+}
+
+// GetTransaction fetches a transaction of an order by ID.
+//
+// If no such transaction exists, a nil transaction and no error is returned.
+func (c *AdminClient) GetTransaction(ctx context.Context, orderID OrderID, id TransactionID, fields SelectedFields) (*Transaction, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &transactionEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("orders/%d/transactions/%d.json", orderID, id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Transaction, nil
+}
+
+// CreateTransaction creates a transaction on an order.
+//
+// Check the status of the returned transaction to know whether it
+// succeeded.
+func (c *AdminClient) CreateTransaction(ctx context.Context, orderID OrderID, transaction Transaction) (*Transaction, error) {
+	if transaction.Amount != nil && transaction.Currency == "" {
+		transaction.Currency = transaction.Amount.CurrencyCode
+	}
+
+	body := &transactionEnvelope{Transaction: transaction}
+	result := &transactionEnvelope{}
+
+	if _, err := c.doJSON(withoutRetry(ctx), http.MethodPost, fmt.Sprintf("orders/%d/transactions.json", orderID), nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Transaction, nil
+}
+
+// CaptureTransaction captures a previously authorized amount.
+//
+// If amount is nil, the full authorized amount is captured.
+func (c *AdminClient) CaptureTransaction(ctx context.Context, orderID OrderID, authorizationID TransactionID, amount *Money) (*Transaction, error) {
+	return c.CreateTransaction(ctx, orderID, Transaction{
+		Kind:     TransactionKindCapture,
+		ParentID: authorizationID,
+		Amount:   amount,
+	})
+}
+
+// VoidTransaction voids a pending authorization or capture.
+func (c *AdminClient) VoidTransaction(ctx context.Context, orderID OrderID, parentID TransactionID) (*Transaction, error) {
+	return c.CreateTransaction(ctx, orderID, Transaction{
+		Kind:     TransactionKindVoid,
+		ParentID: parentID,
+	})
+}
+
+// RefundTransaction refunds a captured amount.
+//
+// To refund line items and restock them, use CreateRefund instead.
+func (c *AdminClient) RefundTransaction(ctx context.Context, orderID OrderID, parentID TransactionID, amount Money) (*Transaction, error) {
+	return c.CreateTransaction(ctx, orderID, Transaction{
+		Kind:     TransactionKindRefund,
+		ParentID: parentID,
+		Amount:   &amount,
+	})
+}
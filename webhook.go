@@ -0,0 +1,383 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// WebhookID is an ID of a webhook subscription.
+type WebhookID int
+
+// WebhookFormat represents the format of webhook payloads.
+type WebhookFormat string
+
+const (
+	// WebhookFormatJSON indicates that payloads are sent as JSON. This is the
+	// default.
+	WebhookFormatJSON WebhookFormat = "json"
+	// WebhookFormatXML indicates that payloads are sent as XML.
+	WebhookFormatXML WebhookFormat = "xml"
+)
+
+// Webhook represents a webhook subscription.
+//
+// Empty fields are omitted when creating or updating a webhook, so that only
+// the specified fields are changed.
+type Webhook struct {
+	ID                  WebhookID     `json:"id,omitempty"`
+	Address             string        `json:"address,omitempty"`
+	Topic               WebhookTopic  `json:"topic,omitempty"`
+	Format              WebhookFormat `json:"format,omitempty"`
+	Fields              []string      `json:"fields,omitempty"`
+	MetafieldNamespaces []string      `json:"metafield_namespaces,omitempty"`
+	APIVersion          APIVersion    `json:"api_version,omitempty"`
+	CreatedAt           *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time    `json:"updated_at,omitempty"`
+}
+
+type webhookEnvelope struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// WebhookFilter represents filters to apply when listing webhooks.
+type WebhookFilter struct {
+	Address      string
+	Topic        WebhookTopic
+	CreatedAtMin time.Time
+	CreatedAtMax time.Time
+	UpdatedAtMin time.Time
+	UpdatedAtMax time.Time
+}
+
+func (f *WebhookFilter) injectInto(values url.Values) {
+	if f == nil {
+		return
+	}
+
+	setString(values, "address", f.Address)
+	setString(values, "topic", string(f.Topic))
+	setTime(values, "created_at_min", f.CreatedAtMin)
+	setTime(values, "created_at_max", f.CreatedAtMax)
+	setTime(values, "updated_at_min", f.UpdatedAtMin)
+	setTime(values, "updated_at_max", f.UpdatedAtMax)
+}
+
+// GetAllWebhooks retrieves a list of all webhooks matching the filter.
+func (c *AdminClient) GetAllWebhooks(ctx context.Context, filter *WebhookFilter, fields SelectedFields) ([]Webhook, error) {
+	pagination := &Pagination{
+		Limit: MaxLimit,
+	}
+
+	var result []Webhook
+
+	for page := 1; pagination != nil; page++ {
+		webhooks, links, err := c.GetWebhooks(ctx, filter, pagination, fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("fetching page %d: %w", page, err)
+		}
+
+		result = append(result, webhooks...)
+		pagination = links.Next
+	}
+
+	return result, nil
+}
+
+// GetWebhooks retrieves a list of webhooks matching the filter.
+//
+// The returned links can be used to fetch the adjacent pages. To fetch the
+// complete list, use GetAllWebhooks.
+func (c *AdminClient) GetWebhooks(ctx context.Context, filter *WebhookFilter, pagination *Pagination, fields SelectedFields) ([]Webhook, *PageLinks, error) {
+	values := url.Values{}
+
+	if !pagination.hasCursor() {
+		filter.injectInto(values)
+	}
+
+	pagination.injectInto(values)
+	fields.injectInto(values)
+
+	result := &struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}{}
+
+	header, err := c.doJSON(ctx, http.MethodGet, "webhooks.json", values, nil, http.StatusOK, result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Webhooks, parsePageLinks(header), nil
+}
+
+// GetWebhooksCount retrieves the count of all webhooks matching the filter.
+func (c *AdminClient) GetWebhooksCount(ctx context.Context, filter *WebhookFilter) (int, error) {
+	values := url.Values{}
+	filter.injectInto(values)
+
+	return c.getCount(ctx, "webhooks/count.json", values)
+}
+
+// GetWebhook fetches a webhook by ID.
+//
+// If no such webhook exists, a nil webhook and no error is returned.
+func (c *AdminClient) GetWebhook(ctx context.Context, id WebhookID, fields SelectedFields) (*Webhook, error) {
+	values := url.Values{}
+	fields.injectInto(values)
+
+	result := &webhookEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("webhooks/%d.json", id), values, nil, http.StatusOK, result); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result.Webhook, nil
+}
+
+// CreateWebhook creates a webhook subscription.
+func (c *AdminClient) CreateWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	body := &webhookEnvelope{Webhook: webhook}
+	result := &webhookEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPost, "webhooks.json", nil, body, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Webhook, nil
+}
+
+// UpdateWebhook updates a webhook subscription.
+//
+// The topic of a webhook cannot be changed.
+func (c *AdminClient) UpdateWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	body := &webhookEnvelope{Webhook: webhook}
+	result := &webhookEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("webhooks/%d.json", webhook.ID), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Webhook, nil
+}
+
+// DeleteWebhook deletes a webhook subscription.
+func (c *AdminClient) DeleteWebhook(ctx context.Context, id WebhookID) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("webhooks/%d.json", id), nil, nil, http.StatusOK, nil)
+
+	return err
+}
+
+// WebhooksReport represents the changes made by EnsureWebhooks.
+type WebhooksReport struct {
+	// Created are the webhooks that were created.
+	Created []Webhook
+
+	// Updated are the webhooks that were updated.
+	Updated []Webhook
+
+	// Deleted are the webhooks that were deleted.
+	Deleted []Webhook
+
+	// Unchanged are the webhooks that already matched.
+	Unchanged []Webhook
+}
+
+// IsEmpty returns true if EnsureWebhooks made no change.
+func (r *WebhooksReport) IsEmpty() bool {
+	return len(r.Created) == 0 && len(r.Updated) == 0 && len(r.Deleted) == 0
+}
+
+type webhookKey struct {
+	Topic   WebhookTopic
+	Address string
+}
+
+func (w Webhook) key() webhookKey {
+	return webhookKey{Topic: w.Topic, Address: w.Address}
+}
+
+func (w Webhook) format() WebhookFormat {
+	if w.Format == "" {
+		return WebhookFormatJSON
+	}
+
+	return w.Format
+}
+
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matches returns true if w has the same address and settings as desired.
+func (w Webhook) matches(desired Webhook) bool {
+	return w.Address == desired.Address &&
+		w.format() == desired.format() &&
+		sameStringSet(w.Fields, desired.Fields) &&
+		sameStringSet(w.MetafieldNamespaces, desired.MetafieldNamespaces)
+}
+
+// updateWebhookTo updates an existing webhook to match a desired one.
+func (c *AdminClient) updateWebhookTo(ctx context.Context, id WebhookID, desired Webhook) (*Webhook, error) {
+	// Fields are not omitted here, so that they are cleared if needed.
+	body := &struct {
+		Webhook struct {
+			ID                  WebhookID     `json:"id"`
+			Address             string        `json:"address"`
+			Format              WebhookFormat `json:"format"`
+			Fields              []string      `json:"fields"`
+			MetafieldNamespaces []string      `json:"metafield_namespaces"`
+		} `json:"webhook"`
+	}{}
+
+	body.Webhook.ID = id
+	body.Webhook.Address = desired.Address
+	body.Webhook.Format = desired.format()
+	body.Webhook.Fields = append([]string{}, desired.Fields...)
+	body.Webhook.MetafieldNamespaces = append([]string{}, desired.MetafieldNamespaces...)
+
+	result := &webhookEnvelope{}
+
+	if _, err := c.doJSON(ctx, http.MethodPut, fmt.Sprintf("webhooks/%d.json", id), nil, body, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return &result.Webhook, nil
+}
+
+// EnsureWebhooks makes sure that the shop has exactly the specified webhook
+// subscriptions.
+//
+// Existing webhooks are first paired with the desired webhooks of the same
+// topic and address, then with the remaining desired webhooks of the same
+// topic. Paired webhooks whose address, format, fields or metafield
+// namespaces differ are updated in place, so that the topic is never left
+// without a subscription. Missing webhooks are then created, and any other
+// webhook, including duplicates, is deleted last.
+//
+// If an operation fails, EnsureWebhooks stops and returns the error, along
+// with a report of the changes made so far.
+func (c *AdminClient) EnsureWebhooks(ctx context.Context, desired ...Webhook) (*WebhooksReport, error) {
+	existing, err := c.GetAllWebhooks(ctx, nil, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing webhooks: %w", err)
+	}
+
+	// Remove the duplicates from the desired webhooks, keeping the first.
+	var targets []Webhook
+	seen := make(map[webhookKey]bool, len(desired))
+
+	for _, webhook := range desired {
+		if !seen[webhook.key()] {
+			seen[webhook.key()] = true
+			targets = append(targets, webhook)
+		}
+	}
+
+	// paired[i] is the index of the existing webhook paired with targets[i],
+	// or -1.
+	paired := make([]int, len(targets))
+	used := make([]bool, len(existing))
+	byKey := make(map[webhookKey]int, len(targets))
+
+	for i, target := range targets {
+		paired[i] = -1
+		byKey[target.key()] = i
+	}
+
+	for j, webhook := range existing {
+		if i, ok := byKey[webhook.key()]; ok && paired[i] < 0 {
+			paired[i] = j
+			used[j] = true
+		}
+	}
+
+	for i, target := range targets {
+		if paired[i] >= 0 {
+			continue
+		}
+
+		for j, webhook := range existing {
+			if !used[j] && webhook.Topic == target.Topic {
+				paired[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	report := &WebhooksReport{}
+
+	for i, target := range targets {
+		if paired[i] < 0 {
+			continue
+		}
+
+		webhook := existing[paired[i]]
+
+		if webhook.matches(target) {
+			report.Unchanged = append(report.Unchanged, webhook)
+			continue
+		}
+
+		updated, err := c.updateWebhookTo(ctx, webhook.ID, target)
+
+		if err != nil {
+			return report, fmt.Errorf("failed to update webhook `%s` to `%s`: %w", webhook.Topic, webhook.Address, err)
+		}
+
+		report.Updated = append(report.Updated, *updated)
+	}
+
+	for i, target := range targets {
+		if paired[i] >= 0 {
+			continue
+		}
+
+		created, err := c.CreateWebhook(ctx, target)
+
+		if err != nil {
+			return report, fmt.Errorf("failed to create webhook `%s` to `%s`: %w", target.Topic, target.Address, err)
+		}
+
+		report.Created = append(report.Created, *created)
+	}
+
+	for j, webhook := range existing {
+		if used[j] {
+			continue
+		}
+
+		if err := c.DeleteWebhook(ctx, webhook.ID); err != nil {
+			return report, fmt.Errorf("failed to delete webhook `%s` to `%s`: %w", webhook.Topic, webhook.Address, err)
+		}
+
+		report.Deleted = append(report.Deleted, webhook)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,119 @@
+package shopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestEnsureWebhooks(t *testing.T) {
+	prefix := "/admin/api/" + string(DefaultAPIVersion) + "/"
+	var calls []string
+
+	ctx, client := newTestAdminClient(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path := strings.TrimPrefix(req.URL.Path, prefix)
+		calls = append(calls, req.Method+" "+path)
+
+		switch req.Method + " " + path {
+		case "GET webhooks.json":
+			w.Write([]byte(`{"webhooks":[
+				{"id":1,"topic":"orders/create","address":"https://example.com/webhooks","format":"json"},
+				{"id":2,"topic":"products/update","address":"https://example.com/webhooks","format":"json","fields":["id","title"]},
+				{"id":3,"topic":"orders/create","address":"https://example.com/webhooks","format":"json"},
+				{"id":4,"topic":"shop/update","address":"https://old.example.com/webhooks","format":"json"},
+				{"id":6,"topic":"carts/create","address":"https://example.com/webhooks","format":"json"}
+			]}`))
+		case "PUT webhooks/2.json":
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"webhook":{"id":2,"address":"https://example.com/webhooks","format":"json","fields":[],"metafield_namespaces":[]}}`
+
+			if string(body) != expected {
+				t.Errorf("expected: %s\ngot: %s", expected, string(body))
+			}
+
+			w.Write([]byte(`{"webhook":{"id":2,"topic":"products/update","address":"https://example.com/webhooks","format":"json"}}`))
+		case "PUT webhooks/4.json":
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"webhook":{"id":4,"address":"https://example.com/webhooks","format":"json","fields":[],"metafield_namespaces":[]}}`
+
+			if string(body) != expected {
+				t.Errorf("expected: %s\ngot: %s", expected, string(body))
+			}
+
+			w.Write([]byte(`{"webhook":{"id":4,"topic":"shop/update","address":"https://example.com/webhooks","format":"json"}}`))
+		case "DELETE webhooks/3.json", "DELETE webhooks/6.json":
+			w.Write([]byte(`{}`))
+		case "POST webhooks.json":
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"webhook":{"address":"https://example.com/webhooks","topic":"app/uninstalled"}}`
+
+			if string(body) != expected {
+				t.Errorf("expected: %s\ngot: %s", expected, string(body))
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"webhook":{"id":5,"topic":"app/uninstalled","address":"https://example.com/webhooks","format":"json"}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", req.Method, path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	report, err := client.EnsureWebhooks(ctx,
+		Webhook{Topic: "orders/create", Address: "https://example.com/webhooks"},
+		Webhook{Topic: "products/update", Address: "https://example.com/webhooks"},
+		Webhook{Topic: "app/uninstalled", Address: "https://example.com/webhooks"},
+		Webhook{Topic: "shop/update", Address: "https://example.com/webhooks"},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	if len(report.Unchanged) != 1 || report.Unchanged[0].ID != 1 {
+		t.Errorf("unexpected unchanged webhooks: %#v", report.Unchanged)
+	}
+
+	if len(report.Updated) != 2 || report.Updated[0].ID != 2 || report.Updated[1].ID != 4 || report.Updated[1].Address != "https://example.com/webhooks" {
+		t.Errorf("unexpected updated webhooks: %#v", report.Updated)
+	}
+
+	if len(report.Deleted) != 2 || report.Deleted[0].ID != 3 || report.Deleted[1].ID != 6 {
+		t.Errorf("unexpected deleted webhooks: %#v", report.Deleted)
+	}
+
+	if len(report.Created) != 1 || report.Created[0].ID != 5 {
+		t.Errorf("unexpected created webhooks: %#v", report.Created)
+	}
+
+	// The stray webhooks are deleted last.
+	expected := "[GET webhooks.json PUT webhooks/2.json PUT webhooks/4.json POST webhooks.json DELETE webhooks/3.json DELETE webhooks/6.json]"
+
+	if fmt.Sprint(calls) != expected {
+		t.Errorf("expected `%s` but got `%v`", expected, calls)
+	}
+}
+
+func TestDecodeWebhookPayload(t *testing.T) {
+	payload, err := DecodeWebhookPayload(WebhookTopicOrdersCreate, []byte(`{"id":1,"total_price":"19.99"}`))
+
+	if err != nil {
+		t.Fatalf("expected no error but got: %s", err)
+	}
+
+	order, ok := payload.(*Order)
+
+	if !ok {
+		t.Fatalf("expected an order but got: %#v", payload)
+	}
+
+	if order.ID != 1 || order.TotalPrice.String() != "19.99" {
+		t.Errorf("unexpected order: %#v", order)
+	}
+
+	if _, err := DecodeWebhookPayload(WebhookTopicShopRedact, []byte(`{}`)); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,196 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// WebhookTopic represents the topic of a webhook, such as "orders/create".
+type WebhookTopic string
+
+// The webhook topics of the resources supported by this package, as
+// documented at
+// https://shopify.dev/docs/admin-api/rest/reference/events/webhook. Other
+// topics can be used by converting their name to a WebhookTopic.
+const (
+	WebhookTopicAppUninstalled WebhookTopic = "app/uninstalled"
+
+	WebhookTopicBulkOperationsFinish WebhookTopic = "bulk_operations/finish"
+
+	WebhookTopicCartsCreate WebhookTopic = "carts/create"
+	WebhookTopicCartsUpdate WebhookTopic = "carts/update"
+
+	WebhookTopicCheckoutsCreate WebhookTopic = "checkouts/create"
+	WebhookTopicCheckoutsUpdate WebhookTopic = "checkouts/update"
+	WebhookTopicCheckoutsDelete WebhookTopic = "checkouts/delete"
+
+	WebhookTopicCollectionsCreate WebhookTopic = "collections/create"
+	WebhookTopicCollectionsUpdate WebhookTopic = "collections/update"
+	WebhookTopicCollectionsDelete WebhookTopic = "collections/delete"
+
+	WebhookTopicCustomerGroupsCreate WebhookTopic = "customer_groups/create"
+	WebhookTopicCustomerGroupsUpdate WebhookTopic = "customer_groups/update"
+	WebhookTopicCustomerGroupsDelete WebhookTopic = "customer_groups/delete"
+
+	WebhookTopicCustomersCreate  WebhookTopic = "customers/create"
+	WebhookTopicCustomersUpdate  WebhookTopic = "customers/update"
+	WebhookTopicCustomersDelete  WebhookTopic = "customers/delete"
+	WebhookTopicCustomersEnable  WebhookTopic = "customers/enable"
+	WebhookTopicCustomersDisable WebhookTopic = "customers/disable"
+
+	WebhookTopicDraftOrdersCreate WebhookTopic = "draft_orders/create"
+	WebhookTopicDraftOrdersUpdate WebhookTopic = "draft_orders/update"
+	WebhookTopicDraftOrdersDelete WebhookTopic = "draft_orders/delete"
+
+	WebhookTopicFulfillmentsCreate WebhookTopic = "fulfillments/create"
+	WebhookTopicFulfillmentsUpdate WebhookTopic = "fulfillments/update"
+
+	WebhookTopicFulfillmentOrdersCancellationRequestAccepted  WebhookTopic = "fulfillment_orders/cancellation_request_accepted"
+	WebhookTopicFulfillmentOrdersCancellationRequestRejected  WebhookTopic = "fulfillment_orders/cancellation_request_rejected"
+	WebhookTopicFulfillmentOrdersCancellationRequestSubmitted WebhookTopic = "fulfillment_orders/cancellation_request_submitted"
+	WebhookTopicFulfillmentOrdersCancelled                    WebhookTopic = "fulfillment_orders/cancelled"
+	WebhookTopicFulfillmentOrdersFulfillmentRequestAccepted   WebhookTopic = "fulfillment_orders/fulfillment_request_accepted"
+	WebhookTopicFulfillmentOrdersFulfillmentRequestRejected   WebhookTopic = "fulfillment_orders/fulfillment_request_rejected"
+	WebhookTopicFulfillmentOrdersFulfillmentRequestSubmitted  WebhookTopic = "fulfillment_orders/fulfillment_request_submitted"
+	WebhookTopicFulfillmentOrdersHoldReleased                 WebhookTopic = "fulfillment_orders/hold_released"
+	WebhookTopicFulfillmentOrdersMoved                        WebhookTopic = "fulfillment_orders/moved"
+	WebhookTopicFulfillmentOrdersOrderRoutingComplete         WebhookTopic = "fulfillment_orders/order_routing_complete"
+	WebhookTopicFulfillmentOrdersPlacedOnHold                 WebhookTopic = "fulfillment_orders/placed_on_hold"
+	WebhookTopicFulfillmentOrdersRescheduled                  WebhookTopic = "fulfillment_orders/rescheduled"
+
+	WebhookTopicInventoryItemsCreate WebhookTopic = "inventory_items/create"
+	WebhookTopicInventoryItemsUpdate WebhookTopic = "inventory_items/update"
+	WebhookTopicInventoryItemsDelete WebhookTopic = "inventory_items/delete"
+
+	WebhookTopicInventoryLevelsConnect    WebhookTopic = "inventory_levels/connect"
+	WebhookTopicInventoryLevelsUpdate     WebhookTopic = "inventory_levels/update"
+	WebhookTopicInventoryLevelsDisconnect WebhookTopic = "inventory_levels/disconnect"
+
+	WebhookTopicLocationsCreate WebhookTopic = "locations/create"
+	WebhookTopicLocationsUpdate WebhookTopic = "locations/update"
+	WebhookTopicLocationsDelete WebhookTopic = "locations/delete"
+
+	WebhookTopicOrderTransactionsCreate WebhookTopic = "order_transactions/create"
+
+	WebhookTopicOrdersCreate             WebhookTopic = "orders/create"
+	WebhookTopicOrdersUpdated            WebhookTopic = "orders/updated"
+	WebhookTopicOrdersDelete             WebhookTopic = "orders/delete"
+	WebhookTopicOrdersCancelled          WebhookTopic = "orders/cancelled"
+	WebhookTopicOrdersFulfilled          WebhookTopic = "orders/fulfilled"
+	WebhookTopicOrdersPartiallyFulfilled WebhookTopic = "orders/partially_fulfilled"
+	WebhookTopicOrdersPaid               WebhookTopic = "orders/paid"
+	WebhookTopicOrdersEdited             WebhookTopic = "orders/edited"
+
+	WebhookTopicProductsCreate WebhookTopic = "products/create"
+	WebhookTopicProductsUpdate WebhookTopic = "products/update"
+	WebhookTopicProductsDelete WebhookTopic = "products/delete"
+
+	WebhookTopicRefundsCreate WebhookTopic = "refunds/create"
+
+	WebhookTopicShopUpdate WebhookTopic = "shop/update"
+
+	WebhookTopicThemesCreate  WebhookTopic = "themes/create"
+	WebhookTopicThemesUpdate  WebhookTopic = "themes/update"
+	WebhookTopicThemesPublish WebhookTopic = "themes/publish"
+	WebhookTopicThemesDelete  WebhookTopic = "themes/delete"
+
+	// The mandatory privacy webhooks, which are configured on the app
+	// rather than subscribed to.
+	WebhookTopicCustomersDataRequest WebhookTopic = "customers/data_request"
+	WebhookTopicCustomersRedact      WebhookTopic = "customers/redact"
+	WebhookTopicShopRedact           WebhookTopic = "shop/redact"
+)
+
+// webhookPayloadTypes maps the topics to the type of their payload.
+//
+// The "bulk_operations/*" and "fulfillment_orders/*" payloads reference the
+// resources by their GraphQL ID and have no corresponding type.
+//
+// The payload of "delete" topics only contains the ID of the deleted
+// resource, which is decoded in a resource of the same type as the other
+// topics.
+var webhookPayloadTypes = map[WebhookTopic]reflect.Type{
+	WebhookTopicAppUninstalled: reflect.TypeOf(ShopDetails{}),
+
+	WebhookTopicCustomerGroupsCreate: reflect.TypeOf(CustomerSavedSearch{}),
+	WebhookTopicCustomerGroupsUpdate: reflect.TypeOf(CustomerSavedSearch{}),
+	WebhookTopicCustomerGroupsDelete: reflect.TypeOf(CustomerSavedSearch{}),
+
+	WebhookTopicCustomersCreate:  reflect.TypeOf(Customer{}),
+	WebhookTopicCustomersUpdate:  reflect.TypeOf(Customer{}),
+	WebhookTopicCustomersDelete:  reflect.TypeOf(Customer{}),
+	WebhookTopicCustomersEnable:  reflect.TypeOf(Customer{}),
+	WebhookTopicCustomersDisable: reflect.TypeOf(Customer{}),
+
+	WebhookTopicDraftOrdersCreate: reflect.TypeOf(DraftOrder{}),
+	WebhookTopicDraftOrdersUpdate: reflect.TypeOf(DraftOrder{}),
+	WebhookTopicDraftOrdersDelete: reflect.TypeOf(DraftOrder{}),
+
+	WebhookTopicFulfillmentsCreate: reflect.TypeOf(Fulfillment{}),
+	WebhookTopicFulfillmentsUpdate: reflect.TypeOf(Fulfillment{}),
+
+	WebhookTopicInventoryItemsCreate: reflect.TypeOf(InventoryItem{}),
+	WebhookTopicInventoryItemsUpdate: reflect.TypeOf(InventoryItem{}),
+	WebhookTopicInventoryItemsDelete: reflect.TypeOf(InventoryItem{}),
+
+	WebhookTopicInventoryLevelsConnect:    reflect.TypeOf(InventoryLevel{}),
+	WebhookTopicInventoryLevelsUpdate:     reflect.TypeOf(InventoryLevel{}),
+	WebhookTopicInventoryLevelsDisconnect: reflect.TypeOf(InventoryLevel{}),
+
+	WebhookTopicLocationsCreate: reflect.TypeOf(Location{}),
+	WebhookTopicLocationsUpdate: reflect.TypeOf(Location{}),
+	WebhookTopicLocationsDelete: reflect.TypeOf(Location{}),
+
+	WebhookTopicOrderTransactionsCreate: reflect.TypeOf(Transaction{}),
+
+	WebhookTopicOrdersCreate:             reflect.TypeOf(Order{}),
+	WebhookTopicOrdersUpdated:            reflect.TypeOf(Order{}),
+	WebhookTopicOrdersDelete:             reflect.TypeOf(Order{}),
+	WebhookTopicOrdersCancelled:          reflect.TypeOf(Order{}),
+	WebhookTopicOrdersFulfilled:          reflect.TypeOf(Order{}),
+	WebhookTopicOrdersPartiallyFulfilled: reflect.TypeOf(Order{}),
+	WebhookTopicOrdersPaid:               reflect.TypeOf(Order{}),
+
+	WebhookTopicProductsCreate: reflect.TypeOf(Product{}),
+	WebhookTopicProductsUpdate: reflect.TypeOf(Product{}),
+	WebhookTopicProductsDelete: reflect.TypeOf(Product{}),
+
+	WebhookTopicRefundsCreate: reflect.TypeOf(Refund{}),
+
+	WebhookTopicShopUpdate: reflect.TypeOf(ShopDetails{}),
+
+	WebhookTopicThemesCreate:  reflect.TypeOf(Theme{}),
+	WebhookTopicThemesUpdate:  reflect.TypeOf(Theme{}),
+	WebhookTopicThemesPublish: reflect.TypeOf(Theme{}),
+	WebhookTopicThemesDelete:  reflect.TypeOf(Theme{}),
+}
+
+// PayloadType returns the type of the payload of the topic, such as Order
+// for "orders/create".
+//
+// If the payload of the topic has no corresponding type in this package, nil
+// is returned and the payload must be decoded by the caller.
+func (t WebhookTopic) PayloadType() reflect.Type {
+	return webhookPayloadTypes[t]
+}
+
+// DecodeWebhookPayload decodes the payload of a webhook.
+//
+// The result is a pointer to the payload type of the topic, such as *Order
+// for "orders/create".
+func DecodeWebhookPayload(topic WebhookTopic, data []byte) (interface{}, error) {
+	typ := topic.PayloadType()
+
+	if typ == nil {
+		return nil, fmt.Errorf("no payload type for webhook topic `%s`", topic)
+	}
+
+	payload := reflect.New(typ).Interface()
+
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("failed to decode `%s` webhook payload: %s", topic, err)
+	}
+
+	return payload, nil
+}